@@ -0,0 +1,177 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go-article/pkg/keyring"
+	"os"
+	"text/tabwriter"
+)
+
+const usage = `Usage:
+  api                                     jalankan HTTP server
+  api keys list     [-dir DIR]            tampilkan kunci JWT
+  api keys generate [-dir DIR] [-alg EdDSA|RS256] [-bits 3072]
+                                          buat kunci privat baru (menjadi kunci penandatangan terbaru)
+  api keys rotate   [-dir DIR] [-alg EdDSA|RS256] [-bits 3072] [-force]
+                                          buat kunci baru lalu ubah kunci privat lain menjadi kunci verifikasi saja,
+                                          -force wajib jika JWT_SIGNING_KEY diisi karena env tersebut harus diganti
+  api keys remove   [-dir DIR] -kid KID   hapus kunci verifikasi setelah semua token-nya kedaluwarsa
+
+DIR bawaan diambil dari env JWT_KEYS_DIR.
+`
+
+// runCommand menjalankan subcommand CLI dan mengembalikan exit code
+func runCommand(args []string) int {
+	var err error
+	switch args[0] {
+	case "keys":
+		err = runKeysCommand(args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return 0
+	default:
+		err = fmt.Errorf("unknown command %q", args[0])
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		if errors.Is(err, flag.ErrHelp) || errors.Is(err, errUsage) {
+			fmt.Fprint(os.Stderr, usage)
+		}
+		return 1
+	}
+	return 0
+}
+
+var errUsage = errors.New("invalid usage")
+
+func runKeysCommand(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	flags := flag.NewFlagSet("keys "+args[0], flag.ContinueOnError)
+	dir := flags.String("dir", os.Getenv("JWT_KEYS_DIR"), "direktori file kunci PEM")
+	algorithm := flags.String("alg", keyring.AlgorithmEdDSA, "algoritma kunci baru: EdDSA atau RS256")
+	bits := flags.Int("bits", keyring.DefaultRSABits, "ukuran kunci RSA")
+	kid := flags.String("kid", "", "kid kunci yang dihapus")
+	force := flags.Bool("force", false, "tetap rotasi walaupun JWT_SIGNING_KEY menunjuk kunci yang akan diubah menjadi kunci verifikasi")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *dir == "" {
+		return errors.New("key directory is not set, use -dir or JWT_KEYS_DIR")
+	}
+
+	switch args[0] {
+	case "list":
+		return listKeys(*dir)
+	case "generate":
+		key, err := keyring.Generate(*dir, *algorithm, *bits)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Generated %s key %s\n", key.Algorithm, key.ID)
+		return nil
+	case "rotate":
+		return rotateKeys(*dir, *algorithm, *bits, *force)
+	case "remove":
+		return removeKey(*dir, *kid)
+	default:
+		return errUsage
+	}
+}
+
+func listKeys(dir string) error {
+	keys, err := keyring.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	signing := signingKeyID(keys)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KID\tALG\tUSE")
+	for _, key := range keys {
+		use := "verify"
+		if key.ID == signing {
+			use = "sign, verify"
+		} else if key.CanSign() {
+			use = "verify (private key present)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", key.ID, key.Algorithm, use)
+	}
+	return w.Flush()
+}
+
+// rotateKeys membuat kunci penandatangan baru. Kunci lama tetap dipublikasikan di JWKS dan tetap
+// menerima token yang sudah terbit, hapus dengan "keys remove" setelah ACCESS_TOKEN_TTL berlalu.
+// Jika JWT_SIGNING_KEY diisi, kunci yang ditunjuknya tidak bisa menandatangani lagi setelah rotasi
+// sehingga aplikasi gagal start sampai env tersebut diganti, karena itu rotasi ditolak tanpa force.
+func rotateKeys(dir string, algorithm string, bits int, force bool) error {
+	pinned := os.Getenv("JWT_SIGNING_KEY")
+	if pinned != "" && !force {
+		return fmt.Errorf("JWT_SIGNING_KEY pins key %s, which rotation turns into a verification only key and the next start would fail. "+
+			"Clear JWT_SIGNING_KEY before rotating, or rerun with -force and set JWT_SIGNING_KEY to the new kid before restarting", pinned)
+	}
+
+	previous, err := keyring.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	key, err := keyring.Generate(dir, algorithm, bits)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Generated %s key %s, now used for signing\n", key.Algorithm, key.ID)
+
+	for _, old := range previous {
+		if !old.CanSign() {
+			continue
+		}
+		if err := keyring.Demote(dir, old); err != nil {
+			return err
+		}
+		fmt.Printf("Key %s is now verification only\n", old.ID)
+	}
+	if pinned != "" {
+		fmt.Printf("JWT_SIGNING_KEY still pins key %s, set JWT_SIGNING_KEY=%s before restarting\n", pinned, key.ID)
+	}
+	return nil
+}
+
+func removeKey(dir string, kid string) error {
+	if kid == "" {
+		return errUsage
+	}
+
+	keys, err := keyring.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if kid == signingKeyID(keys) {
+		return fmt.Errorf("key %s is the current signing key, rotate first", kid)
+	}
+
+	if err := keyring.Remove(dir, kid); err != nil {
+		return err
+	}
+	fmt.Printf("Removed key %s\n", kid)
+	return nil
+}
+
+// signingKeyID menentukan kid penandatangan dengan aturan yang sama seperti keyring.LoadDir
+func signingKeyID(keys []*keyring.Key) string {
+	if kid := os.Getenv("JWT_SIGNING_KEY"); kid != "" {
+		return kid
+	}
+	signing := ""
+	for _, key := range keys {
+		if key.CanSign() && key.ID > signing {
+			signing = key.ID
+		}
+	}
+	return signing
+}
@@ -1,28 +1,121 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"go-article/database/seeds"
 	"go-article/internal/config"
+	"go-article/internal/repository"
 	"go-article/internal/routes"
+	"go-article/internal/scheduler"
+	"go-article/internal/search"
+	"go-article/internal/service"
+	"go-article/pkg/keyring"
+	"go-article/pkg/utils"
+	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
 	// Load environment variables
 	config.LoadEnv()
 
+	// Subcommand CLI, misalnya "keys rotate", dijalankan tanpa membuka koneksi database
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(os.Args[1:]))
+	}
+
+	// Keyring JWT dimuat sekali saat start, file kunci yang rusak menggagalkan start lebih awal
+	keys, err := keyring.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	utils.UseKeyring(keys)
+
 	// Connect to the database
 	config.ConnectDatabase()
 
 	seeds.SeedRoles(config.DB)
+	seeds.SeedAdminUser(config.DB)
+
+	// Hook yang dijalankan setiap kali artikel terbit (log, webhook)
+	publishNotifier := service.NewPublishNotifierFromEnv()
+
+	// Indeks pencarian dipakai bersama oleh API dan scheduler agar artikel yang terbit otomatis ikut terindeks
+	searchIndex := search.NewFromEnv(config.DB)
+	if searchIndex.NeedsRebuild() {
+		indexed, err := service.NewSearchService(searchIndex, repository.NewArticleRepository(config.DB)).Rebuild()
+		if err != nil {
+			log.Fatalf("Failed to build search index: %v", err)
+		}
+		log.Printf("Search index built with %d articles", indexed)
+	}
+
+	// Session service dipakai bersama oleh API dan scheduler agar cache pencabutan token hanya ada satu
+	sessionService := service.NewSessionService(repository.NewUserRepository(config.DB), repository.NewRefreshTokenRepository(config.DB), repository.NewRevokedTokenRepository(config.DB))
 
 	// Setup Router
-	r := routes.SetupRoutes(config.DB)
+	r := routes.SetupRoutes(config.DB, publishNotifier, searchIndex, sessionService, keys)
 
 	// Run Server
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
-	r.Run(":" + port)
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: r,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Scheduler bisa dimatikan di sebagian replika dengan SCHEDULER_ENABLED=false
+	jobs := scheduler.New()
+	if utils.BoolFromEnv("SCHEDULER_ENABLED", true) {
+		articleRepository := repository.NewArticleRepository(config.DB)
+		userRepository := repository.NewUserRepository(config.DB)
+		workflowService := service.NewArticleWorkflowService(articleRepository, userRepository, publishNotifier, searchIndex)
+
+		jobs.Every(
+			"publish-scheduled-articles",
+			utils.DurationFromEnv("SCHEDULER_INTERVAL", 30*time.Second),
+			scheduler.PublishScheduledArticles(workflowService, utils.IntFromEnv("SCHEDULER_BATCH_SIZE", 50)),
+		)
+
+		jobs.Every(
+			"purge-revoked-tokens",
+			utils.DurationFromEnv("REVOKED_TOKEN_PURGE_INTERVAL", time.Hour),
+			scheduler.PurgeRevokedTokens(sessionService),
+		)
+	}
+	jobs.Start(ctx)
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+	log.Printf("Server listening on :%s", port)
+
+	<-ctx.Done()
+	log.Println("Shutting down...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), utils.DurationFromEnv("SHUTDOWN_TIMEOUT", 15*time.Second))
+	defer cancel()
+
+	// Berhenti menerima request baru, lalu hentikan scheduler dan tunggu hook yang masih berjalan
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Server shutdown: %v", err)
+	}
+	if err := jobs.Stop(shutdownCtx); err != nil {
+		log.Printf("Scheduler shutdown: %v", err)
+	}
+	if err := publishNotifier.Wait(shutdownCtx); err != nil {
+		log.Printf("Publish hooks shutdown: %v", err)
+	}
 }
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS articles (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    author_id BIGINT UNSIGNED NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    excerpt VARCHAR(500) NULL DEFAULT NULL,
+    body LONGTEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    published_at DATETIME NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL DEFAULT NULL,
+    INDEX idx_articles_author_id (author_id),
+    INDEX idx_articles_status_published_at (status, published_at),
+    INDEX idx_articles_deleted_at (deleted_at),
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+ALTER TABLE user_role
+    DROP FOREIGN KEY fk_user_role_granted_by,
+    DROP COLUMN granted_by,
+    DROP COLUMN created_at,
+    DROP COLUMN updated_at;
@@ -0,0 +1,5 @@
+ALTER TABLE user_role
+    ADD COLUMN granted_by BIGINT UNSIGNED NULL DEFAULT NULL,
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    ADD CONSTRAINT fk_user_role_granted_by FOREIGN KEY (granted_by) REFERENCES users(id) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE articles
+    DROP FOREIGN KEY fk_articles_category,
+    DROP INDEX idx_articles_category_id,
+    DROP COLUMN category_id;
@@ -0,0 +1,4 @@
+ALTER TABLE articles
+    ADD COLUMN category_id BIGINT UNSIGNED NULL DEFAULT NULL AFTER author_id,
+    ADD INDEX idx_articles_category_id (category_id),
+    ADD CONSTRAINT fk_articles_category FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS article_transitions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT UNSIGNED NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor_id BIGINT UNSIGNED NULL DEFAULT NULL,
+    comment TEXT NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_article_transitions_article_id (article_id, created_at),
+    CONSTRAINT fk_article_transitions_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_transitions_actor FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE SET NULL
+);
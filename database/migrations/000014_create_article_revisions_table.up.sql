@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS article_revisions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT UNSIGNED NOT NULL,
+    revision_number INT UNSIGNED NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    excerpt VARCHAR(500) NULL DEFAULT NULL,
+    body LONGTEXT NOT NULL,
+    editor_id BIGINT UNSIGNED NULL DEFAULT NULL,
+    restored_from_id BIGINT UNSIGNED NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_article_revisions_number (article_id, revision_number),
+    CONSTRAINT fk_article_revisions_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_revisions_editor FOREIGN KEY (editor_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_article_revisions_restored_from FOREIGN KEY (restored_from_id) REFERENCES article_revisions(id) ON DELETE SET NULL
+);
+
+-- Artikel yang sudah ada mendapat revisi pertama dari isi saat ini
+INSERT INTO article_revisions (article_id, revision_number, title, excerpt, body, editor_id, created_at)
+SELECT id, 1, title, excerpt, body, author_id, updated_at FROM articles;
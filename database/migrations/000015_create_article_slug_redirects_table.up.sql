@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS article_slug_redirects (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT UNSIGNED NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_article_slug_redirects_article (article_id),
+    CONSTRAINT fk_article_slug_redirects_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
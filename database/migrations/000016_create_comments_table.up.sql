@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT UNSIGNED NOT NULL,
+    parent_id BIGINT UNSIGNED NULL DEFAULT NULL,
+    root_id BIGINT UNSIGNED NULL DEFAULT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    moderated_by BIGINT UNSIGNED NULL DEFAULT NULL,
+    moderated_at DATETIME NULL DEFAULT NULL,
+    edited_at DATETIME NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL DEFAULT NULL,
+    INDEX idx_comments_article_thread (article_id, parent_id, status, created_at),
+    INDEX idx_comments_root_id (root_id),
+    INDEX idx_comments_status (status, created_at),
+    CONSTRAINT fk_comments_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_root FOREIGN KEY (root_id) REFERENCES comments(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_moderated_by FOREIGN KEY (moderated_by) REFERENCES users(id) ON DELETE SET NULL
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS article_reactions (
+    user_id BIGINT UNSIGNED NOT NULL,
+    article_id BIGINT UNSIGNED NOT NULL,
+    reaction VARCHAR(20) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, article_id, reaction),
+    INDEX idx_article_reactions_article (article_id, reaction),
+    CONSTRAINT fk_article_reactions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_reactions_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS article_bookmarks (
+    user_id BIGINT UNSIGNED NOT NULL,
+    article_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, article_id),
+    INDEX idx_article_bookmarks_user_created (user_id, created_at),
+    INDEX idx_article_bookmarks_article (article_id),
+    CONSTRAINT fk_article_bookmarks_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_bookmarks_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
@@ -0,0 +1,8 @@
+ALTER TABLE articles
+    DROP COLUMN like_count,
+    DROP COLUMN love_count,
+    DROP COLUMN laugh_count,
+    DROP COLUMN wow_count,
+    DROP COLUMN sad_count,
+    DROP COLUMN angry_count,
+    DROP COLUMN bookmark_count;
@@ -0,0 +1,8 @@
+ALTER TABLE articles
+    ADD COLUMN like_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER published_at,
+    ADD COLUMN love_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER like_count,
+    ADD COLUMN laugh_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER love_count,
+    ADD COLUMN wow_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER laugh_count,
+    ADD COLUMN sad_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER wow_count,
+    ADD COLUMN angry_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER sad_count,
+    ADD COLUMN bookmark_count INT UNSIGNED NOT NULL DEFAULT 0 AFTER angry_count;
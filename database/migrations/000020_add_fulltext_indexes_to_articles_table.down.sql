@@ -0,0 +1,5 @@
+ALTER TABLE tags DROP INDEX ft_tags_name;
+ALTER TABLE articles
+    DROP INDEX ft_articles_content,
+    DROP INDEX ft_articles_excerpt,
+    DROP INDEX ft_articles_title;
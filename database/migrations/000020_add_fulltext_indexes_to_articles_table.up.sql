@@ -0,0 +1,6 @@
+-- InnoDB hanya bisa membuat satu indeks FULLTEXT per statement.
+-- Indeks per kolom dipakai untuk bobot relevansi, indeks gabungan untuk mencocokkan seluruh isi artikel.
+ALTER TABLE articles ADD FULLTEXT INDEX ft_articles_title (title);
+ALTER TABLE articles ADD FULLTEXT INDEX ft_articles_excerpt (excerpt);
+ALTER TABLE articles ADD FULLTEXT INDEX ft_articles_content (title, excerpt, body);
+ALTER TABLE tags ADD FULLTEXT INDEX ft_tags_name (name);
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    ADD COLUMN failed_login_attempts INT UNSIGNED NOT NULL DEFAULT 0 AFTER status,
+    ADD COLUMN locked_until DATETIME NULL DEFAULT NULL AFTER failed_login_attempts;
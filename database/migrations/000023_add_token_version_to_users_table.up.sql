@@ -0,0 +1,2 @@
+ALTER TABLE users
+    ADD COLUMN token_version BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER locked_until;
@@ -9,8 +9,9 @@ import (
 
 func SeedRoles(db *gorm.DB) {
 	roles := []model.Role{
-		{Name: "Admin"},
-		{Name: "User"},
+		{Name: model.RoleAdmin},
+		{Name: model.RoleEditor},
+		{Name: model.RoleUser},
 	}
 
 	for _, role := range roles {
@@ -1 +1,55 @@
 package seeds
+
+import (
+	"go-article/internal/domain/model"
+	"go-article/pkg/utils"
+	"log"
+	"os"
+
+	"gorm.io/gorm"
+)
+
+// SeedAdminUser membuat akun admin pertama dari env ADMIN_EMAIL dan ADMIN_PASSWORD.
+// Registrasi publik tidak bisa memilih role, sehingga admin awal harus dibuat lewat seed ini.
+func SeedAdminUser(db *gorm.DB) {
+	email := os.Getenv("ADMIN_EMAIL")
+	password := os.Getenv("ADMIN_PASSWORD")
+	if email == "" || password == "" {
+		log.Println("[SeedAdminUser] ADMIN_EMAIL or ADMIN_PASSWORD not set, skipping")
+		return
+	}
+
+	name := os.Getenv("ADMIN_NAME")
+	if name == "" {
+		name = "Administrator"
+	}
+
+	var adminRole model.Role
+	if err := db.Where("name = ?", model.RoleAdmin).First(&adminRole).Error; err != nil {
+		log.Fatalf("[SeedAdminUser] admin role not found: %v", err)
+	}
+
+	var user model.User
+	err := db.Where("email = ?", email).First(&user).Error
+	if err == nil {
+		log.Printf("[SeedAdminUser] Admin user already exists: %s", email)
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		log.Fatalf("[SeedAdminUser] failed to hash password: %v", err)
+	}
+
+	user = model.User{
+		Name:     name,
+		Email:    email,
+		Password: hashedPassword,
+		Roles:    []model.Role{adminRole},
+	}
+	if err := db.Create(&user).Error; err != nil {
+		log.Fatalf("[SeedAdminUser] failed to create admin user: %v", err)
+	}
+
+	log.Printf("[SeedAdminUser] Seeded admin user: %s", email)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"go-article/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const principalKey = "auth_principal"
+
+// Principal adalah user yang terautentikasi pada sebuah request, dibentuk dari claims access token
+type Principal struct {
+	UserID       uint64
+	Roles        []string
+	TokenID      string
+	TokenVersion uint64
+	ExpiresAt    time.Time
+}
+
+// NewPrincipal membuat Principal dari claims access token yang sudah divalidasi
+func NewPrincipal(claims *utils.Claims) (*Principal, error) {
+	userID, err := claims.UserID()
+	if err != nil {
+		return nil, err
+	}
+
+	principal := &Principal{
+		UserID:       userID,
+		Roles:        claims.Roles,
+		TokenID:      claims.ID,
+		TokenVersion: claims.TokenVersion,
+	}
+	if claims.ExpiresAt != nil {
+		principal.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return principal, nil
+}
+
+// HasRole menandakan token membawa minimal satu dari role yang diberikan.
+// Role di token bisa tertinggal hingga token kedaluwarsa, gunakan middleware.RequireRoles untuk otorisasi.
+func (p *Principal) HasRole(roles ...string) bool {
+	for _, owned := range p.Roles {
+		for _, role := range roles {
+			if strings.EqualFold(owned, role) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetCurrentUser menyimpan principal ke context, dipanggil oleh AuthMiddleware
+func SetCurrentUser(c *gin.Context, principal *Principal) {
+	c.Set(principalKey, principal)
+}
+
+// CurrentUser mengembalikan principal yang diset oleh AuthMiddleware, false jika request tidak terautentikasi
+func CurrentUser(c *gin.Context) (*Principal, bool) {
+	value, exists := c.Get(principalKey)
+	if !exists {
+		return nil, false
+	}
+	principal, ok := value.(*Principal)
+	return principal, ok && principal != nil
+}
@@ -32,7 +32,8 @@ func ConnectDatabase() {
 		os.Getenv("DB_NAME"),
 	)
 
-	db, err := gorm.Open(mysql.Open(c), &gorm.Config{})
+	// TranslateError mengubah error duplicate key MySQL menjadi gorm.ErrDuplicatedKey
+	db, err := gorm.Open(mysql.Open(c), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
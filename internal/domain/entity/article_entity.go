@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+type ArticleAuthor struct {
+	ID     uint64
+	Name   string
+	Avatar *string
+}
+
+type ArticleCategory struct {
+	ID   uint64
+	Name string
+	Slug string
+}
+
+type ArticleTag struct {
+	ID   uint64
+	Name string
+	Slug string
+}
+
+type ArticleEntity struct {
+	ID         uint64
+	Title      string
+	Slug       string
+	Excerpt    *string
+	Body       string
+	Status     string
+	AuthorID   uint64
+	Author     *ArticleAuthor
+	CategoryID *uint64
+	Category   *ArticleCategory
+	Tags       []ArticleTag
+	// Reactions berisi jumlah setiap reaksi, misalnya {"like": 10, "love": 2}
+	Reactions     map[string]int64
+	BookmarkCount int64
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ArticleEngagementEntity adalah ringkasan reaksi dan bookmark artikel dari sudut pandang user
+type ArticleEngagementEntity struct {
+	ArticleID     uint64
+	Reactions     map[string]int64
+	BookmarkCount int64
+	// MyReactions dan Bookmarked hanya terisi untuk user yang login
+	MyReactions []string
+	Bookmarked  bool
+}
+
+// ArticleSearchResultEntity adalah artikel hasil pencarian beserta skor relevansi dan highlight-nya
+type ArticleSearchResultEntity struct {
+	Article   ArticleEntity
+	Score     float64
+	Highlight ArticleSearchHighlight
+}
+
+// ArticleSearchHighlight berisi judul dan potongan isi artikel dengan kata yang cocok dibungkus <mark>.
+// Teks sudah di-escape sehingga aman dirender sebagai HTML.
+type ArticleSearchHighlight struct {
+	Title   string
+	Snippet string
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type ArticleRevisionEntity struct {
+	ID             uint64
+	ArticleID      uint64
+	RevisionNumber int
+	Title          string
+	Excerpt        *string
+	Body           string
+	EditorID       *uint64
+	Editor         *ArticleAuthor
+	RestoredFromID *uint64
+	CreatedAt      time.Time
+}
+
+type ArticleRevisionDiffEntity struct {
+	ArticleID    uint64
+	From         int
+	To           int
+	TitleFrom    string
+	TitleTo      string
+	TitleChanged bool
+	// Diff berisi unified diff baris per baris dari body, kosong jika body tidak berubah
+	Diff string
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type ArticleTransitionEntity struct {
+	ID         uint64
+	ArticleID  uint64
+	Action     string
+	FromStatus string
+	ToStatus   string
+	ActorID    *uint64
+	Actor      *ArticleAuthor
+	Comment    *string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,9 @@
+package entity
+
+// AuthToken adalah pasangan access token (JWT) dan refresh token (opaque) hasil login/refresh
+type AuthToken struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn adalah masa berlaku access token dalam detik
+	ExpiresIn int64
+}
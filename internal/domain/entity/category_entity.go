@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type CategoryEntity struct {
+	ID          uint64
+	ParentID    *uint64
+	Name        string
+	Slug        string
+	Description *string
+	Path        string
+	Depth       int
+	Position    int
+	Children    []CategoryEntity
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type CommentArticle struct {
+	ID    uint64
+	Title string
+	Slug  string
+}
+
+type CommentEntity struct {
+	ID        uint64
+	ArticleID uint64
+	Article   *CommentArticle
+	ParentID  *uint64
+	RootID    *uint64
+	UserID    uint64
+	User      *ArticleAuthor
+	Body      string
+	Status    string
+	// Deleted bernilai true untuk komentar yang sudah dihapus tetapi masih punya balasan,
+	// Body dan User-nya dikosongkan dan hanya ditampilkan sebagai penanda posisi di thread
+	Deleted     bool
+	ModeratedBy *uint64
+	ModeratedAt *time.Time
+	EditedAt    *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Replies     []CommentEntity
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type LoginAttemptEntity struct {
+	ID        uint64
+	UserID    *uint64
+	Email     string
+	IPAddress string
+	UserAgent string
+	Result    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type TagEntity struct {
+	ID   uint64
+	Name string
+	Slug string
+	// ArticleCount adalah jumlah artikel (yang belum dihapus) yang memakai tag ini
+	ArticleCount int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
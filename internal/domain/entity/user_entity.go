@@ -1,6 +1,9 @@
 package entity
 
-import "go-article/internal/domain/model"
+import (
+	"go-article/internal/domain/model"
+	"time"
+)
 
 type UserEntity struct {
 	ID         uint64
@@ -9,5 +12,20 @@ type UserEntity struct {
 	Password   string `json:"-"`
 	Avatar     *string
 	VerifiedAt *string
-	Roles      []model.Role
+	Status     string
+	// FailedLoginAttempts dan LockedUntil menunjukkan status penguncian akun akibat gagal login
+	FailedLoginAttempts uint
+	LockedUntil         *time.Time
+	// TokenVersion dimasukkan ke access token, token dengan versi lain ditolak
+	TokenVersion uint64
+	Roles        []model.Role
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+// UserSessionState adalah data user yang diperiksa AuthMiddleware pada setiap request
+type UserSessionState struct {
+	ID           uint64
+	Status       string
+	TokenVersion uint64
 }
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusInReview  = "in_review"
+	ArticleStatusScheduled = "scheduled"
+	ArticleStatusPublished = "published"
+	ArticleStatusArchived  = "archived"
+)
+
+type Article struct {
+	ID          uint64     `gorm:"primaryKey;autoIncrement"`
+	AuthorID    uint64     `gorm:"not null;index:idx_articles_author_id"`
+	Author      User       `gorm:"foreignKey:AuthorID"`
+	CategoryID  *uint64    `gorm:"index:idx_articles_category_id"`
+	Category    *Category  `gorm:"foreignKey:CategoryID"`
+	Tags        []Tag      `gorm:"many2many:article_tag;"`
+	Title       string     `gorm:"type:varchar(255);not null"`
+	Slug        string     `gorm:"type:varchar(255);unique;not null"`
+	Excerpt     *string    `gorm:"type:varchar(500)"`
+	Body        string     `gorm:"type:longtext;not null"`
+	Status      string     `gorm:"type:varchar(20);not null;default:draft"`
+	PublishedAt *time.Time `gorm:"index:idx_articles_status_published_at"`
+	// Counter reaksi dan bookmark diperbarui bersamaan dengan baris article_reactions/article_bookmarks
+	LikeCount     int64      `gorm:"not null;default:0"`
+	LoveCount     int64      `gorm:"not null;default:0"`
+	LaughCount    int64      `gorm:"not null;default:0"`
+	WowCount      int64      `gorm:"not null;default:0"`
+	SadCount      int64      `gorm:"not null;default:0"`
+	AngryCount    int64      `gorm:"not null;default:0"`
+	BookmarkCount int64      `gorm:"not null;default:0"`
+	CreatedAt     time.Time  `gorm:"type:timestamp;default:current_timestamp"`
+	UpdatedAt     time.Time  `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
+	DeletedAt     *time.Time `gorm:"index"`
+}
+
+func (Article) TableName() string {
+	return "articles"
+}
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+const (
+	ReactionLike  = "like"
+	ReactionLove  = "love"
+	ReactionLaugh = "laugh"
+	ReactionWow   = "wow"
+	ReactionSad   = "sad"
+	ReactionAngry = "angry"
+)
+
+// Reactions adalah daftar reaksi yang didukung beserta urutan tampilnya
+var Reactions = []string{ReactionLike, ReactionLove, ReactionLaugh, ReactionWow, ReactionSad, ReactionAngry}
+
+// ReactionCountColumns memetakan reaksi ke kolom counter-nya di tabel articles
+var ReactionCountColumns = map[string]string{
+	ReactionLike:  "like_count",
+	ReactionLove:  "love_count",
+	ReactionLaugh: "laugh_count",
+	ReactionWow:   "wow_count",
+	ReactionSad:   "sad_count",
+	ReactionAngry: "angry_count",
+}
+
+// ArticleReaction adalah reaksi satu user pada artikel, satu user bisa memberi beberapa reaksi berbeda
+type ArticleReaction struct {
+	UserID    uint64    `gorm:"primaryKey"`
+	ArticleID uint64    `gorm:"primaryKey;index:idx_article_reactions_article"`
+	Reaction  string    `gorm:"primaryKey;type:varchar(20)"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleReaction) TableName() string {
+	return "article_reactions"
+}
+
+// ArticleBookmark adalah artikel yang disimpan user untuk dibaca nanti
+type ArticleBookmark struct {
+	UserID    uint64    `gorm:"primaryKey"`
+	ArticleID uint64    `gorm:"primaryKey;index:idx_article_bookmarks_article"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleBookmark) TableName() string {
+	return "article_bookmarks"
+}
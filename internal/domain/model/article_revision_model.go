@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// ArticleRevision adalah salinan isi artikel yang tidak pernah diubah setelah dibuat
+type ArticleRevision struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	ArticleID      uint64    `gorm:"not null;uniqueIndex:uq_article_revisions_number"`
+	RevisionNumber int       `gorm:"not null;uniqueIndex:uq_article_revisions_number"`
+	Title          string    `gorm:"type:varchar(255);not null"`
+	Excerpt        *string   `gorm:"type:varchar(500)"`
+	Body           string    `gorm:"type:longtext;not null"`
+	EditorID       *uint64   `gorm:"index"`
+	Editor         *User     `gorm:"foreignKey:EditorID"`
+	RestoredFromID *uint64   `gorm:"index"`
+	CreatedAt      time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleRevision) TableName() string {
+	return "article_revisions"
+}
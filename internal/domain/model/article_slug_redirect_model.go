@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// ArticleSlugRedirect menyimpan slug lama artikel agar URL yang sudah tersebar tetap bisa diarahkan ke slug baru
+type ArticleSlugRedirect struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	ArticleID uint64    `gorm:"not null;index:idx_article_slug_redirects_article"`
+	Slug      string    `gorm:"type:varchar(255);unique;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleSlugRedirect) TableName() string {
+	return "article_slug_redirects"
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type ArticleTag struct {
+	ArticleID uint64    `gorm:"primaryKey"`
+	TagID     uint64    `gorm:"primaryKey;index:idx_article_tag_tag_id"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleTag) TableName() string {
+	return "article_tag"
+}
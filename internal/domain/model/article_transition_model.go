@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+const (
+	ArticleActionSubmit  = "submit"
+	ArticleActionApprove = "approve"
+	ArticleActionReject  = "reject"
+	ArticleActionPublish = "publish"
+	ArticleActionArchive = "archive"
+	ArticleActionRevise  = "revise"
+)
+
+// ArticleTransition mencatat setiap perubahan status artikel, ActorID nil berarti dilakukan oleh sistem
+type ArticleTransition struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement"`
+	ArticleID  uint64    `gorm:"not null;index:idx_article_transitions_article_id"`
+	Action     string    `gorm:"type:varchar(20);not null"`
+	FromStatus string    `gorm:"type:varchar(20);not null"`
+	ToStatus   string    `gorm:"type:varchar(20);not null"`
+	ActorID    *uint64   `gorm:"index"`
+	Actor      *User     `gorm:"foreignKey:ActorID"`
+	Comment    *string   `gorm:"type:text"`
+	CreatedAt  time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (ArticleTransition) TableName() string {
+	return "article_transitions"
+}
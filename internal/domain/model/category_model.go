@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Category disimpan sebagai materialized path, misalnya "/1/5/12/" untuk Tech > Go > Web,
+// sehingga seluruh subtree bisa diambil dengan satu query LIKE pada kolom path.
+type Category struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	ParentID    *uint64   `gorm:"index:idx_categories_parent_id"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	Slug        string    `gorm:"type:varchar(120);unique;not null"`
+	Description *string   `gorm:"type:varchar(500)"`
+	Path        string    `gorm:"type:varchar(255);not null;default:/;index:idx_categories_path"`
+	Depth       int       `gorm:"not null;default:0"`
+	Position    int       `gorm:"not null;default:0"`
+	CreatedAt   time.Time `gorm:"type:timestamp;default:current_timestamp"`
+	UpdatedAt   time.Time `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
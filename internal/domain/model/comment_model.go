@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+	CommentStatusSpam     = "spam"
+)
+
+// Comment adalah komentar pembaca pada artikel. ParentID menunjuk komentar yang dibalas,
+// RootID menunjuk komentar level teratas dari thread-nya sehingga satu thread bisa diambil dengan satu query.
+type Comment struct {
+	ID          uint64   `gorm:"primaryKey;autoIncrement"`
+	ArticleID   uint64   `gorm:"not null;index:idx_comments_article_thread,priority:1"`
+	Article     *Article `gorm:"foreignKey:ArticleID"`
+	ParentID    *uint64  `gorm:"index:idx_comments_article_thread,priority:2"`
+	RootID      *uint64  `gorm:"index:idx_comments_root_id"`
+	UserID      uint64   `gorm:"not null"`
+	User        User     `gorm:"foreignKey:UserID"`
+	Body        string   `gorm:"type:text;not null"`
+	Status      string   `gorm:"type:varchar(20);not null;default:pending;index:idx_comments_article_thread,priority:3"`
+	ModeratedBy *uint64  `gorm:"column:moderated_by"`
+	ModeratedAt *time.Time
+	EditedAt    *time.Time
+	CreatedAt   time.Time  `gorm:"type:timestamp;default:current_timestamp"`
+	UpdatedAt   time.Time  `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
+	DeletedAt   *time.Time `gorm:"index"`
+}
+
+func (Comment) TableName() string {
+	return "comments"
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+const (
+	LoginResultSuccess            = "success"
+	LoginResultInvalidCredentials = "invalid_credentials"
+	LoginResultLocked             = "locked"
+	LoginResultThrottled          = "throttled"
+	LoginResultSuspended          = "suspended"
+)
+
+// LoginAttempt adalah catatan audit setiap percobaan login, UserID nil berarti email tidak terdaftar
+type LoginAttempt struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID    *uint64   `gorm:"index:idx_login_attempts_user_id"`
+	Email     string    `gorm:"type:varchar(255);not null;index:idx_login_attempts_email"`
+	IPAddress string    `gorm:"type:varchar(45);not null;index:idx_login_attempts_ip_result"`
+	UserAgent string    `gorm:"type:varchar(512);not null;default:''"`
+	Result    string    `gorm:"type:varchar(30);not null;index:idx_login_attempts_ip_result"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (LoginAttempt) TableName() string {
+	return "login_attempts"
+}
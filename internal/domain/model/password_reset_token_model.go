@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type PasswordResetToken struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID    uint64    `gorm:"not null;index:idx_password_reset_tokens_user_id"`
+	TokenHash string    `gorm:"type:char(64);unique;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type RefreshToken struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID       uint64    `gorm:"not null;index:idx_refresh_tokens_user_id"`
+	FamilyID     string    `gorm:"type:char(32);not null;index:idx_refresh_tokens_family_id"`
+	TokenHash    string    `gorm:"type:char(64);unique;not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RevokedAt    *time.Time
+	ReplacedByID *uint64
+	CreatedAt    time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
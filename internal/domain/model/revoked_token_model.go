@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RevokedToken adalah jti access token yang dicabut sebelum kedaluwarsa, baris dihapus setelah ExpiresAt
+type RevokedToken struct {
+	JTI       string    `gorm:"column:jti;type:varchar(64);primaryKey"`
+	UserID    uint64    `gorm:"not null;index:idx_revoked_tokens_user_id"`
+	ExpiresAt time.Time `gorm:"not null;index:idx_revoked_tokens_expires_at"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+}
+
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
@@ -2,6 +2,12 @@ package model
 
 import "time"
 
+const (
+	RoleAdmin  = "Admin"
+	RoleEditor = "Editor"
+	RoleUser   = "User"
+)
+
 type Role struct {
 	ID        uint64    `gorm:"primaryKey;autoIncrement"`
 	Name      string    `gorm:"type:varchar(100);unique;not null"`
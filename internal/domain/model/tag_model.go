@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type Tag struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Name      string    `gorm:"type:varchar(50);not null;index:idx_tags_name"`
+	Slug      string    `gorm:"type:varchar(60);unique;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;default:current_timestamp"`
+	UpdatedAt time.Time `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
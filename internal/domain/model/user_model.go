@@ -4,6 +4,11 @@ import (
 	"time"
 )
 
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+)
+
 type User struct {
 	ID         uint64     `gorm:"primaryKey;autoIncrement"`
 	Name       string     `gorm:"type:varchar(255);not null"`
@@ -11,8 +16,15 @@ type User struct {
 	Password   string     `gorm:"type:varchar(255);not null"`
 	Avatar     *string    `gorm:"type:varchar(512)"`
 	VerifiedAt *time.Time `gorm:"column:verify_at"`
-	Roles      []Role     `gorm:"many2many:user_role;"`
-	CreatedAt  time.Time  `gorm:"type:timestamp;default:current_timestamp"`
-	UpdatedAt  time.Time  `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
-	DeletedAt  *time.Time `gorm:"index"`
+	Status     string     `gorm:"type:varchar(20);not null;default:active;index:idx_users_status"`
+	// FailedLoginAttempts adalah jumlah gagal login berturut-turut sejak login berhasil atau dibuka admin terakhir
+	FailedLoginAttempts uint `gorm:"not null;default:0"`
+	// LockedUntil adalah batas waktu akun tidak bisa login karena terlalu banyak gagal login
+	LockedUntil *time.Time
+	// TokenVersion dinaikkan untuk mencabut semua access token user yang sudah terbit
+	TokenVersion uint64     `gorm:"not null;default:0"`
+	Roles        []Role     `gorm:"many2many:user_role;"`
+	CreatedAt    time.Time  `gorm:"type:timestamp;default:current_timestamp"`
+	UpdatedAt    time.Time  `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
+	DeletedAt    *time.Time `gorm:"index"`
 }
@@ -5,6 +5,7 @@ import "time"
 type UserRole struct {
 	UserID    uint64     `gorm:"primaryKey"`
 	RoleID    uint64     `gorm:"primaryKey"`
+	GrantedBy *uint64    `gorm:"index"`
 	CreatedAt time.Time  `gorm:"type:timestamp;default:current_timestamp"`
 	UpdatedAt *time.Time `gorm:"type:timestamp;default:current_timestamp on update current_timestamp"`
 }
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminUserHandler struct {
+	adminUserService service.AdminUserService
+}
+
+func NewAdminUserHandler(adminUserService service.AdminUserService) *AdminUserHandler {
+	return &AdminUserHandler{adminUserService: adminUserService}
+}
+
+func (h *AdminUserHandler) List(c *gin.Context) {
+	var query request.AdminUserListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if query.UsesCursor() {
+		users, cursor, err := h.adminUserService.ListUsersByCursor(query)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response := utils.APIResponseWithCursor("Users fetched successfully", http.StatusOK, "success", users, cursor)
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	users, total, err := h.adminUserService.ListUsers(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Users fetched successfully", http.StatusOK, "success", users, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Show(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.adminUserService.GetUser(userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User fetched successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Update(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.AdminUpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	user, err := h.adminUserService.UpdateUser(userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User updated successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Delete(c *gin.Context) {
+	adminID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.adminUserService.DeleteUser(adminID, userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Restore(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.adminUserService.RestoreUser(userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User restored successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Suspend(c *gin.Context) {
+	adminID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.adminUserService.SuspendUser(adminID, userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User suspended successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Activate(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.adminUserService.ActivateUser(userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User activated successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) Unlock(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.adminUserService.UnlockUser(userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User unlocked successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) SignOut(c *gin.Context) {
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.adminUserService.SignOutUser(userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("User signed out from all devices", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminUserHandler) LoginAttempts(c *gin.Context) {
+	var query request.LoginAttemptListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	attempts, total, err := h.adminUserService.ListLoginAttempts(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Login attempts fetched successfully", http.StatusOK, "success", attempts, pagination)
+	c.JSON(http.StatusOK, response)
+}
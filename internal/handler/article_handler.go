@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ArticleHandler struct {
+	articleService service.ArticleService
+}
+
+func NewArticleHandler(articleService service.ArticleService) *ArticleHandler {
+	return &ArticleHandler{articleService: articleService}
+}
+
+func (h *ArticleHandler) Create(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	var req request.CreateArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	article, err := h.articleService.Create(userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article created successfully", http.StatusCreated, "success", article, nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *ArticleHandler) List(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	var query request.ArticleListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if query.UsesCursor() {
+		articles, cursor, err := h.articleService.ListByCursor(userID, query)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response := utils.APIResponseWithCursor("Articles fetched successfully", http.StatusOK, "success", articles, cursor)
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	articles, total, err := h.articleService.List(userID, query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+
+	response := utils.APIResponseWithPagination("Articles fetched successfully", http.StatusOK, "success", articles, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+// Show menampilkan artikel berdasarkan ID atau slug. Route ini publik, login hanya diperlukan
+// untuk melihat artikel sendiri yang belum terbit. Slug lama dijawab dengan 301 ke slug terbaru.
+func (h *ArticleHandler) Show(c *gin.Context) {
+	// Pengunjung tanpa login memakai userID 0
+	userID, _ := currentUserID(c)
+
+	if articleID, err := strconv.ParseUint(c.Param("id"), 10, 64); err == nil {
+		article, err := h.articleService.GetByID(userID, articleID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response := utils.APIResponse("Article fetched successfully", http.StatusOK, "success", article, nil)
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	article, moved, err := h.articleService.GetBySlug(userID, c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if moved {
+		location := "/articles/" + article.Slug
+		c.Header("Location", location)
+		response := utils.APIResponse("Article moved permanently", http.StatusMovedPermanently, "success", gin.H{
+			"id":       article.ID,
+			"slug":     article.Slug,
+			"location": location,
+		}, nil)
+		c.JSON(http.StatusMovedPermanently, response)
+		return
+	}
+
+	response := utils.APIResponse("Article fetched successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleHandler) Update(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.UpdateArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	article, err := h.articleService.Update(userID, articleID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article updated successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleHandler) Delete(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.articleService.Delete(userID, articleID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ForceDelete menghapus artikel milik siapa pun, hanya untuk route admin
+func (h *ArticleHandler) ForceDelete(c *gin.Context) {
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.articleService.ForceDelete(articleID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
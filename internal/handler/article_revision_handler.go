@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ArticleRevisionHandler struct {
+	revisionService service.ArticleRevisionService
+}
+
+func NewArticleRevisionHandler(revisionService service.ArticleRevisionService) *ArticleRevisionHandler {
+	return &ArticleRevisionHandler{revisionService: revisionService}
+}
+
+// List menampilkan revisi artikel milik author, dari yang paling baru
+func (h *ArticleRevisionHandler) List(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var query request.RevisionListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	revisions, total, err := h.revisionService.List(userID, articleID, query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Revisions fetched successfully", http.StatusOK, "success", revisions, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleRevisionHandler) Show(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, number, err := revisionParams(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	revision, err := h.revisionService.Get(userID, articleID, number)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Revision fetched successfully", http.StatusOK, "success", revision, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Diff membandingkan body dua revisi, contoh: /articles/1/revisions/diff?from=1&to=3
+func (h *ArticleRevisionHandler) Diff(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var query request.RevisionDiffQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	result, err := h.revisionService.Diff(userID, articleID, query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Revision diff generated successfully", http.StatusOK, "success", result, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Restore mengembalikan isi artikel ke revisi tertentu sebagai revisi baru
+func (h *ArticleRevisionHandler) Restore(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, number, err := revisionParams(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.revisionService.Restore(userID, articleID, number)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Revision restored successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// revisionParams membaca ID artikel dan nomor revisi dari URL
+func revisionParams(c *gin.Context) (uint64, int, error) {
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	number, err := paramID(c, "revision")
+	if err != nil {
+		return 0, 0, err
+	}
+	return articleID, int(number), nil
+}
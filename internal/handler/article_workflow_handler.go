@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ArticleWorkflowHandler struct {
+	workflowService service.ArticleWorkflowService
+}
+
+func NewArticleWorkflowHandler(workflowService service.ArticleWorkflowService) *ArticleWorkflowHandler {
+	return &ArticleWorkflowHandler{workflowService: workflowService}
+}
+
+// Submit mengajukan draft milik author untuk direview editor
+func (h *ArticleWorkflowHandler) Submit(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.workflowService.Submit(userID, articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article submitted for review", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Revise menarik artikel terbit milik author kembali menjadi draft agar bisa diedit
+func (h *ArticleWorkflowHandler) Revise(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.workflowService.Revise(userID, articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article moved back to draft", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Archive mengarsipkan artikel milik author sendiri
+func (h *ArticleWorkflowHandler) Archive(c *gin.Context) {
+	h.archive(c, false)
+}
+
+// ArchiveAsEditor mengarsipkan artikel milik siapa pun, hanya untuk route editor
+func (h *ArticleWorkflowHandler) ArchiveAsEditor(c *gin.Context) {
+	h.archive(c, true)
+}
+
+// History menampilkan riwayat status artikel milik author sendiri
+func (h *ArticleWorkflowHandler) History(c *gin.Context) {
+	h.history(c, false)
+}
+
+// HistoryAsEditor menampilkan riwayat status artikel milik siapa pun, hanya untuk route editor
+func (h *ArticleWorkflowHandler) HistoryAsEditor(c *gin.Context) {
+	h.history(c, true)
+}
+
+func (h *ArticleWorkflowHandler) Queue(c *gin.Context) {
+	var query request.ReviewQueueQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	articles, total, err := h.workflowService.ReviewQueue(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Articles fetched successfully", http.StatusOK, "success", articles, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleWorkflowHandler) Show(c *gin.Context) {
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.workflowService.GetForReview(articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article fetched successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleWorkflowHandler) Approve(c *gin.Context) {
+	editorID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.ApproveArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	article, err := h.workflowService.Approve(editorID, articleID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article approved successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleWorkflowHandler) Reject(c *gin.Context) {
+	editorID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.RejectArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	article, err := h.workflowService.Reject(editorID, articleID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article rejected", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Publish menerbitkan artikel terjadwal sekarang juga tanpa menunggu jadwalnya
+func (h *ArticleWorkflowHandler) Publish(c *gin.Context) {
+	editorID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.workflowService.Publish(&editorID, articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article published successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleWorkflowHandler) archive(c *gin.Context, asEditor bool) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	article, err := h.workflowService.Archive(userID, articleID, asEditor)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article archived successfully", http.StatusOK, "success", article, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ArticleWorkflowHandler) history(c *gin.Context, asEditor bool) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	transitions, err := h.workflowService.History(userID, articleID, asEditor)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Article history fetched successfully", http.StatusOK, "success", transitions, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -5,6 +5,7 @@ import (
 	"go-article/internal/service"
 	"go-article/pkg/utils"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,24 +19,16 @@ func NewAuthHandler(authService service.AuthService) *AuthHandler {
 }
 
 func (h *AuthHandler) Profile(c *gin.Context) {
-	// Ambil user_id dari context
-	userIDInterface, exists := c.Get("user_id")
-	if !exists {
-		response := utils.APIResponse("Unauthorized", http.StatusUnauthorized, "error", nil, "User ID not found in context")
-		c.JSON(http.StatusUnauthorized, response)
-		return
-	}
-	userID, ok := userIDInterface.(float64) // JWT menyimpan angka sebagai float64
+	// Ambil ID user dari principal di context
+	userID, ok := currentUserID(c)
 	if !ok {
-		response := utils.APIResponse("Unauthorized", http.StatusUnauthorized, "error", nil, "Invalid user ID format")
-		c.JSON(http.StatusUnauthorized, response)
+		c.Error(errUnauthorized)
 		return
 	}
 	// Panggil service untuk mendapatkan profil user
-	user, err := h.authService.Profile(uint64(userID))
+	user, err := h.authService.Profile(userID)
 	if err != nil {
-		response := utils.APIResponse("Failed to fetch profile", http.StatusBadRequest, "error", nil, err.Error())
-		c.JSON(http.StatusBadRequest, response)
+		c.Error(err)
 		return
 	}
 	response := utils.APIResponse("Profile fetched successfully", http.StatusOK, "success", user, nil)
@@ -47,30 +40,14 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 	// Validasi input JSON
 	if err := c.ShouldBindJSON(&req); err != nil {
-		errors := utils.FormatValidationError(err)
-		response := utils.APIResponse("Register account failed", http.StatusBadRequest, "error", nil, errors)
-		c.JSON(http.StatusBadRequest, response)
+		c.Error(validationError(c, err))
 		return
 	}
 
 	// Panggil service untuk registrasi
 	user, err := h.authService.Register(req)
 	if err != nil {
-		// Handle error spesifik duplikasi email
-		if err.Error() == "email already registered" {
-			response := utils.APIResponse("Register account failed", http.StatusConflict, "error", nil, gin.H{"email": "Email already registered"})
-			c.JSON(http.StatusConflict, response)
-			return
-		}
-
-		if err.Error() == "role IDs are invalid" {
-			response := utils.APIResponse("Register account failed", http.StatusBadRequest, "error", nil, gin.H{"role_ids": "One or more role IDs are invalid"})
-			c.JSON(http.StatusBadRequest, response)
-			return
-		}
-
-		response := utils.APIResponse("Register account failed", http.StatusBadRequest, "error", nil, err.Error())
-		c.JSON(http.StatusBadRequest, response)
+		c.Error(err)
 		return
 	}
 
@@ -84,25 +61,161 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	// Validasi input JSON
 	if err := c.ShouldBindJSON(&req); err != nil {
-		errors := utils.FormatValidationError(err)
-		response := utils.APIResponse("Login failed", http.StatusBadRequest, "error", nil, errors)
-		c.JSON(http.StatusBadRequest, response)
+		c.Error(validationError(c, err))
 		return
 	}
 
 	// Panggil service untuk login
-	user, token, err := h.authService.Login(req)
+	user, token, err := h.authService.Login(req, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
-		response := utils.APIResponse("Login failed", http.StatusUnauthorized, "error", nil, err.Error())
-		c.JSON(http.StatusUnauthorized, response)
+		c.Error(err)
 		return
 	}
 
 	formatter := gin.H{
-		"token": token,
-		"user":  user,
+		"token":         token.AccessToken,
+		"refresh_token": token.RefreshToken,
+		"expires_in":    token.ExpiresIn,
+		"user":          user,
 	}
 
 	response := utils.APIResponse("Successfuly logged in", http.StatusOK, "success", formatter, nil)
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req request.RefreshTokenRequest
+
+	// Validasi input JSON
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	// Rotasi refresh token lama menjadi pasangan token baru
+	token, err := h.authService.Refresh(req.RefreshToken)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	formatter := gin.H{
+		"token":         token.AccessToken,
+		"refresh_token": token.RefreshToken,
+		"expires_in":    token.ExpiresIn,
+	}
+
+	response := utils.APIResponse("Token refreshed successfully", http.StatusOK, "success", formatter, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req request.RefreshTokenRequest
+
+	// Validasi input JSON
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	// Access token di header Authorization bersifat opsional, jika ada ikut dicabut
+	accessToken := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if err := h.authService.Logout(req.RefreshToken, accessToken); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Successfully logged out", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	// Cabut semua refresh token dan access token milik user di semua perangkat
+	if err := h.authService.LogoutAll(userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Successfully logged out from all devices", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req request.VerifyEmailRequest
+
+	// Token bisa dikirim lewat query (link di email) atau body JSON
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if err := h.authService.VerifyEmail(req.Token); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Email verified successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	var req request.EmailRequest
+
+	// Validasi input JSON
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if err := h.authService.ResendVerification(req.Email); err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Response selalu sama agar tidak membocorkan email mana yang terdaftar
+	response := utils.APIResponse("If the email is registered and not yet verified, a verification link has been sent", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req request.EmailRequest
+
+	// Validasi input JSON
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if err := h.authService.ForgotPassword(req.Email); err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Response selalu sama agar tidak membocorkan email mana yang terdaftar
+	response := utils.APIResponse("If the email is registered, a password reset link has been sent", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req request.ResetPasswordRequest
+
+	// Validasi input JSON
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if err := h.authService.ResetPassword(req.Token, req.Password); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Password has been reset successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryService service.CategoryService
+}
+
+func NewCategoryHandler(categoryService service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{categoryService: categoryService}
+}
+
+func (h *CategoryHandler) Tree(c *gin.Context) {
+	categories, err := h.categoryService.Tree()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Categories fetched successfully", http.StatusOK, "success", categories, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CategoryHandler) Articles(c *gin.Context) {
+	var query request.CategoryArticleListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	articles, total, err := h.categoryService.ListArticles(c.Param("slug"), query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Articles fetched successfully", http.StatusOK, "success", articles, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CategoryHandler) Show(c *gin.Context) {
+	categoryID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	category, err := h.categoryService.GetByID(categoryID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Category fetched successfully", http.StatusOK, "success", category, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CategoryHandler) Create(c *gin.Context) {
+	var req request.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	category, err := h.categoryService.Create(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Category created successfully", http.StatusCreated, "success", category, nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *CategoryHandler) Update(c *gin.Context) {
+	categoryID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	category, err := h.categoryService.Update(categoryID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Category updated successfully", http.StatusOK, "success", category, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CategoryHandler) Delete(c *gin.Context) {
+	categoryID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.categoryService.Delete(categoryID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Category deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CommentHandler struct {
+	commentService service.CommentService
+}
+
+func NewCommentHandler(commentService service.CommentService) *CommentHandler {
+	return &CommentHandler{commentService: commentService}
+}
+
+// List menampilkan thread komentar artikel, pagination berlaku untuk komentar level teratas
+func (h *CommentHandler) List(c *gin.Context) {
+	// Pengunjung tanpa login memakai userID 0
+	userID, _ := currentUserID(c)
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var query request.CommentListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if query.UsesCursor() {
+		comments, cursor, err := h.commentService.ListByCursor(userID, articleID, query)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response := utils.APIResponseWithCursor("Comments fetched successfully", http.StatusOK, "success", comments, cursor)
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	comments, total, err := h.commentService.List(userID, articleID, query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Comments fetched successfully", http.StatusOK, "success", comments, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CommentHandler) Create(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	comment, err := h.commentService.Create(userID, articleID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	message := "Comment posted successfully"
+	if comment.Status == model.CommentStatusPending {
+		message = "Comment submitted and awaiting moderation"
+	}
+
+	response := utils.APIResponse(message, http.StatusCreated, "success", comment, nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *CommentHandler) Update(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, commentID, err := commentParams(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	comment, err := h.commentService.Update(userID, articleID, commentID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Comment updated successfully", http.StatusOK, "success", comment, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CommentHandler) Delete(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, commentID, err := commentParams(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.commentService.Delete(userID, articleID, commentID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Comment deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// Queue menampilkan antrean moderasi komentar, default status pending
+func (h *CommentHandler) Queue(c *gin.Context) {
+	var query request.CommentModerationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	comments, total, err := h.commentService.ModerationQueue(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Comments fetched successfully", http.StatusOK, "success", comments, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CommentHandler) Approve(c *gin.Context) {
+	h.moderate(c, model.CommentStatusApproved, "Comment approved")
+}
+
+func (h *CommentHandler) Reject(c *gin.Context) {
+	h.moderate(c, model.CommentStatusRejected, "Comment rejected")
+}
+
+func (h *CommentHandler) MarkSpam(c *gin.Context) {
+	h.moderate(c, model.CommentStatusSpam, "Comment marked as spam")
+}
+
+func (h *CommentHandler) ForceDelete(c *gin.Context) {
+	commentID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.commentService.ForceDelete(commentID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Comment deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// moderate mengubah status komentar atas nama admin yang sedang login
+func (h *CommentHandler) moderate(c *gin.Context, status string, message string) {
+	moderatorID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	commentID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	comment, err := h.commentService.Moderate(moderatorID, commentID, status)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse(message, http.StatusOK, "success", comment, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// commentParams membaca ID artikel dan ID komentar dari URL
+func commentParams(c *gin.Context) (uint64, uint64, error) {
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	commentID, err := paramID(c, "comment_id")
+	if err != nil {
+		return 0, 0, err
+	}
+	return articleID, commentID, nil
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"go-article/internal/auth"
+	"go-article/pkg/apperror"
+	"go-article/pkg/validation"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errUnauthorized = apperror.Unauthorized("UNAUTHORIZED", "Unauthorized")
+
+// currentUserID mengambil ID user dari principal yang diset oleh AuthMiddleware
+func currentUserID(c *gin.Context) (uint64, bool) {
+	principal, ok := auth.CurrentUser(c)
+	if !ok {
+		return 0, false
+	}
+	return principal.UserID, true
+}
+
+// paramID membaca parameter URL berupa ID numerik
+func paramID(c *gin.Context, name string) (uint64, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 64)
+	if err != nil {
+		return 0, apperror.BadRequest("INVALID_ID", "Invalid "+name+" parameter")
+	}
+	return id, nil
+}
+
+// validationError mengubah error dari ShouldBind menjadi apperror validasi
+// dengan pesan dalam bahasa yang diminta client lewat Accept-Language
+func validationError(c *gin.Context, err error) error {
+	return validation.Translate(err, c.GetHeader("Accept-Language"))
+}
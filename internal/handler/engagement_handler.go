@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EngagementHandler struct {
+	engagementService service.EngagementService
+}
+
+func NewEngagementHandler(engagementService service.EngagementService) *EngagementHandler {
+	return &EngagementHandler{engagementService: engagementService}
+}
+
+// Summary menampilkan jumlah reaksi dan bookmark artikel, beserta reaksi user jika login
+func (h *EngagementHandler) Summary(c *gin.Context) {
+	// Pengunjung tanpa login memakai userID 0
+	userID, _ := currentUserID(c)
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	summary, err := h.engagementService.Summary(userID, articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Reactions fetched successfully", http.StatusOK, "success", summary, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// React menambahkan reaksi, contoh: PUT /articles/1/reactions/love
+func (h *EngagementHandler) React(c *gin.Context) {
+	h.write(c, "Reaction saved", func(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+		return h.engagementService.React(userID, articleID, c.Param("reaction"))
+	})
+}
+
+func (h *EngagementHandler) Unreact(c *gin.Context) {
+	h.write(c, "Reaction removed", func(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+		return h.engagementService.Unreact(userID, articleID, c.Param("reaction"))
+	})
+}
+
+func (h *EngagementHandler) Bookmark(c *gin.Context) {
+	h.write(c, "Article bookmarked", h.engagementService.Bookmark)
+}
+
+func (h *EngagementHandler) Unbookmark(c *gin.Context) {
+	h.write(c, "Bookmark removed", h.engagementService.Unbookmark)
+}
+
+// Bookmarks menampilkan artikel yang di-bookmark user yang sedang login
+func (h *EngagementHandler) Bookmarks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	var query request.BookmarkListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	articles, total, err := h.engagementService.Bookmarks(userID, query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Bookmarks fetched successfully", http.StatusOK, "success", articles, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+// write menjalankan operasi reaksi/bookmark dan mengembalikan ringkasan terbaru.
+// Operasinya idempotent sehingga request yang diulang tetap mendapat 200.
+func (h *EngagementHandler) write(c *gin.Context, message string, action func(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error)) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	articleID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	summary, err := action(userID, articleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse(message, http.StatusOK, "success", summary, nil)
+	c.JSON(http.StatusOK, response)
+}
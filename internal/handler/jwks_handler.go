@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"go-article/pkg/keyring"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWKSHandler struct {
+	keys *keyring.Keyring
+}
+
+func NewJWKSHandler(keys *keyring.Keyring) *JWKSHandler {
+	return &JWKSHandler{keys: keys}
+}
+
+// Show mengembalikan kunci publik JWT dalam format JWK Set agar service lain bisa memverifikasi access token.
+// Response mengikuti RFC 7517 apa adanya (tanpa pembungkus utils.Response) supaya bisa dibaca library JWT standar.
+func (h *JWKSHandler) Show(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.keys.JWKS())
+}
@@ -0,0 +1,70 @@
+package request
+
+import "time"
+
+type CreateArticleRequest struct {
+	Title   string  `json:"title" binding:"required,max=255"`
+	Excerpt *string `json:"excerpt" binding:"omitempty,max=500"`
+	Body    string  `json:"body" binding:"required"`
+	// CategoryID nil berarti artikel tanpa kategori
+	CategoryID *uint64 `json:"category_id" binding:"omitempty,min=1"`
+	// Tags berisi nama tag, tag yang belum ada akan dibuat otomatis
+	Tags []string `json:"tags" binding:"omitempty,max=10,dive,required,max=50"`
+}
+
+type UpdateArticleRequest struct {
+	Title   string  `json:"title" binding:"required,max=255"`
+	Excerpt *string `json:"excerpt" binding:"omitempty,max=500"`
+	Body    string  `json:"body" binding:"required"`
+	// CategoryID nil berarti artikel tanpa kategori
+	CategoryID *uint64 `json:"category_id" binding:"omitempty,min=1"`
+	// Tags nil berarti tag lama dipertahankan, array kosong menghapus semua tag
+	Tags []string `json:"tags" binding:"omitempty,max=10,dive,required,max=50"`
+}
+
+type ArticleListQuery struct {
+	Page  int    `form:"page,default=1" binding:"min=1"`
+	Limit int    `form:"limit,default=10" binding:"min=1,max=100"`
+	Mine  bool   `form:"mine"`
+	Tag   string `form:"tag"`
+	// Status hanya berlaku bersama mine=true, misalnya untuk melihat draft sendiri
+	Status string `form:"status" binding:"omitempty,oneof=draft in_review scheduled published archived"`
+	CursorQuery
+}
+
+type ApproveArticleRequest struct {
+	// PublishAt di masa depan membuat artikel terjadwal, kosong berarti langsung dipublikasikan
+	PublishAt *time.Time `json:"publish_at"`
+	Comment   *string    `json:"comment" binding:"omitempty,max=1000"`
+}
+
+type RejectArticleRequest struct {
+	Comment string `json:"comment" binding:"required,max=1000"`
+}
+
+type ReviewQueueQuery struct {
+	Status string `form:"status,default=in_review" binding:"oneof=in_review scheduled"`
+	Page   int    `form:"page,default=1" binding:"min=1"`
+	Limit  int    `form:"limit,default=10" binding:"min=1,max=100"`
+}
+
+type RevisionListQuery struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+type RevisionDiffQuery struct {
+	From int `form:"from" binding:"required,min=1"`
+	To   int `form:"to" binding:"required,min=1"`
+}
+
+type BookmarkListQuery struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=10" binding:"min=1,max=100"`
+}
+
+type ArticleSearchQuery struct {
+	Q     string `form:"q" binding:"required,min=2,max=200"`
+	Page  int    `form:"page,default=1" binding:"min=1"`
+	Limit int    `form:"limit,default=10" binding:"min=1,max=50"`
+}
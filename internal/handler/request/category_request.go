@@ -0,0 +1,14 @@
+package request
+
+type CategoryRequest struct {
+	// ParentID nil berarti kategori root
+	ParentID    *uint64 `json:"parent_id" binding:"omitempty,min=1"`
+	Name        string  `json:"name" binding:"required,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=500"`
+	Position    int     `json:"position"`
+}
+
+type CategoryArticleListQuery struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=10" binding:"min=1,max=100"`
+}
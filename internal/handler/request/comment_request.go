@@ -0,0 +1,24 @@
+package request
+
+type CommentListQuery struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=10" binding:"min=1,max=50"`
+	CursorQuery
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required,notblank,max=5000"`
+	// ParentID diisi untuk membalas komentar lain pada artikel yang sama
+	ParentID *uint64 `json:"parent_id" binding:"omitempty,min=1"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,notblank,max=5000"`
+}
+
+type CommentModerationQuery struct {
+	Status    string `form:"status,default=pending" binding:"oneof=pending approved rejected spam"`
+	ArticleID uint64 `form:"article_id"`
+	Page      int    `form:"page,default=1" binding:"min=1"`
+	Limit     int    `form:"limit,default=20" binding:"min=1,max=100"`
+}
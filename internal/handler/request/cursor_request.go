@@ -0,0 +1,14 @@
+package request
+
+// CursorQuery mengaktifkan pagination berbasis cursor pada endpoint daftar.
+// Tanpa pagination=cursor atau cursor, endpoint tetap memakai page dan limit.
+type CursorQuery struct {
+	Pagination string `form:"pagination,default=page" binding:"oneof=page cursor"`
+	// Cursor adalah next_cursor atau prev_cursor dari response sebelumnya
+	Cursor string `form:"cursor" binding:"omitempty,max=512"`
+}
+
+// UsesCursor melaporkan apakah request meminta pagination berbasis cursor
+func (q CursorQuery) UsesCursor() bool {
+	return q.Pagination == "cursor" || q.Cursor != ""
+}
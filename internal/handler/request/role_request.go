@@ -0,0 +1,5 @@
+package request
+
+type GrantRoleRequest struct {
+	RoleID uint64 `json:"role_id" binding:"required,gt=0"`
+}
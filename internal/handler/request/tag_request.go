@@ -0,0 +1,20 @@
+package request
+
+type TagListQuery struct {
+	Search string `form:"search" binding:"omitempty,max=50"`
+	Page   int    `form:"page,default=1" binding:"min=1"`
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+type TagAutocompleteQuery struct {
+	Query string `form:"q" binding:"required,max=50"`
+	Limit int    `form:"limit,default=10" binding:"min=1,max=20"`
+}
+
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type MergeTagRequest struct {
+	TargetID uint64 `json:"target_id" binding:"required,min=1"`
+}
@@ -0,0 +1,40 @@
+package request
+
+type UpdateProfileRequest struct {
+	Name *string `json:"name" binding:"omitempty,min=1,max=255"`
+	// Avatar berisi string kosong untuk menghapus avatar
+	Avatar *string `json:"avatar" binding:"omitempty,max=512,url_or_empty"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=6"`
+}
+
+type AdminUserListQuery struct {
+	Search   string `form:"search"`
+	Role     string `form:"role"`
+	Verified *bool  `form:"verified"`
+	Status   string `form:"status" binding:"omitempty,oneof=active suspended"`
+	Deleted  string `form:"deleted,default=without" binding:"oneof=without with only"`
+	Sort     string `form:"sort,default=-created_at" binding:"oneof=name -name email -email created_at -created_at"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	Limit    int    `form:"limit,default=10" binding:"min=1,max=100"`
+	CursorQuery
+}
+
+type AdminUpdateUserRequest struct {
+	Name  *string `json:"name" binding:"omitempty,min=1,max=255"`
+	Email *string `json:"email" binding:"omitempty,email"`
+	// Avatar berisi string kosong untuk menghapus avatar
+	Avatar *string `json:"avatar" binding:"omitempty,max=512,url_or_empty"`
+}
+
+type LoginAttemptListQuery struct {
+	UserID uint64 `form:"user_id"`
+	Email  string `form:"email"`
+	IP     string `form:"ip" binding:"omitempty,ip"`
+	Result string `form:"result" binding:"omitempty,oneof=success invalid_credentials locked throttled suspended"`
+	Page   int    `form:"page,default=1" binding:"min=1"`
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RoleHandler struct {
+	roleService service.RoleService
+}
+
+func NewRoleHandler(roleService service.RoleService) *RoleHandler {
+	return &RoleHandler{roleService: roleService}
+}
+
+func (h *RoleHandler) List(c *gin.Context) {
+	roles, err := h.roleService.ListRoles()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Roles fetched successfully", http.StatusOK, "success", roles, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *RoleHandler) Grant(c *gin.Context) {
+	adminID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.GrantRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	user, err := h.roleService.GrantRole(adminID, userID, req.RoleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Role granted successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *RoleHandler) Revoke(c *gin.Context) {
+	adminID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	userID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	roleID, err := paramID(c, "role_id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user, err := h.roleService.RevokeRole(adminID, userID, roleID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Role revoked successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	searchService service.SearchService
+}
+
+func NewSearchHandler(searchService service.SearchService) *SearchHandler {
+	return &SearchHandler{searchService: searchService}
+}
+
+// Articles mencari artikel terbit berdasarkan judul, excerpt, body dan tag, diurutkan dari yang paling relevan
+func (h *SearchHandler) Articles(c *gin.Context) {
+	var query request.ArticleSearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	results, total, err := h.searchService.Search(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Articles fetched successfully", http.StatusOK, "success", results, pagination)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"go-article/internal/handler/request"
+	"go-article/internal/service"
+	"go-article/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	tagService service.TagService
+}
+
+func NewTagHandler(tagService service.TagService) *TagHandler {
+	return &TagHandler{tagService: tagService}
+}
+
+func (h *TagHandler) List(c *gin.Context) {
+	var query request.TagListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	tags, total, err := h.tagService.List(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	pagination := utils.NewPaginationMeta(query.Page, query.Limit, total)
+	response := utils.APIResponseWithPagination("Tags fetched successfully", http.StatusOK, "success", tags, pagination)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TagHandler) Autocomplete(c *gin.Context) {
+	var query request.TagAutocompleteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	tags, err := h.tagService.Autocomplete(query)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Tags fetched successfully", http.StatusOK, "success", tags, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TagHandler) Rename(c *gin.Context) {
+	tagID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.RenameTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	tag, err := h.tagService.Rename(tagID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Tag renamed successfully", http.StatusOK, "success", tag, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TagHandler) Merge(c *gin.Context) {
+	tagID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req request.MergeTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	tag, err := h.tagService.Merge(tagID, req.TargetID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Tags merged successfully", http.StatusOK, "success", tag, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TagHandler) Delete(c *gin.Context) {
+	tagID, err := paramID(c, "id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.tagService.Delete(tagID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Tag deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
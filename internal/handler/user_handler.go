@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"go-article/internal/handler/request"
 	"go-article/internal/service"
 	"go-article/pkg/utils"
 	"net/http"
@@ -19,16 +20,79 @@ func NewUserHandler(userService service.UserService) *UserHandler {
 }
 
 func (h *UserHandler) Profile(c *gin.Context) {
-	currentUser := c.MustGet("user_id").(float64)
-	userID := uint64(currentUser)
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
 
 	user, err := h.userService.GetUserByID(userID)
 	if err != nil {
-		response := utils.APIResponse("Failed to get user profile", http.StatusBadRequest, "error", nil, err.Error())
-		c.JSON(http.StatusBadRequest, response)
+		c.Error(err)
 		return
 	}
 
 	response := utils.APIResponse("User profile", http.StatusOK, "success", user, nil)
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *UserHandler) UpdateProfile(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	var req request.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	user, err := h.userService.UpdateProfile(userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Profile updated successfully", http.StatusOK, "success", user, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	var req request.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(validationError(c, err))
+		return
+	}
+
+	if err := h.userService.ChangePassword(userID, req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Password changed successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *UserHandler) DeleteAccount(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		c.Error(errUnauthorized)
+		return
+	}
+
+	if err := h.userService.DeleteAccount(userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := utils.APIResponse("Account deleted successfully", http.StatusOK, "success", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
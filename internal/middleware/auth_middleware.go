@@ -1,41 +1,63 @@
 package middleware
 
 import (
+	"go-article/internal/auth"
+	"go-article/internal/service"
+	"go-article/pkg/apperror"
 	"go-article/pkg/utils"
-	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+// AuthMiddleware memvalidasi access token di header Authorization lalu memeriksa ke sessions
+// bahwa token tersebut belum dicabut (user dihapus atau di-suspend, token_version berubah, atau jti di denylist)
+func AuthMiddleware(sessions service.SessionService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if !strings.Contains(authHeader, "Bearer") {
-			response := utils.APIResponse("Unauthorized", http.StatusUnauthorized, "error", nil, "Missing or invalid token")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, response)
+			c.Error(apperror.Unauthorized("MISSING_TOKEN", "Missing or invalid token"))
+			c.Abort()
 			return
 		}
 
 		tokenString := strings.Replace(authHeader, "Bearer ", "", 1)
-		token, err := utils.ValidateToken(tokenString)
+		claims, err := utils.ValidateToken(tokenString)
+		if err != nil {
+			c.Error(apperror.Unauthorized("INVALID_TOKEN", "Invalid token").Wrap(err))
+			c.Abort()
+			return
+		}
 
+		principal, err := auth.NewPrincipal(claims)
 		if err != nil {
-			response := utils.APIResponse("Unauthorized", http.StatusUnauthorized, "error", nil, "Invalid token")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, response)
+			c.Error(apperror.Unauthorized("INVALID_TOKEN", "Invalid token claims").Wrap(err))
+			c.Abort()
 			return
 		}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			// Set user ID dan role ke context
-			c.Set("user_id", claims["user_id"])
-		} else {
-			response := utils.APIResponse("Unauthorized", http.StatusUnauthorized, "error", nil, "Invalid token claims")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, response)
+		if err := sessions.Validate(principal); err != nil {
+			c.Error(err)
+			c.Abort()
 			return
 		}
 
+		// Simpan principal ke context, handler membacanya lewat auth.CurrentUser
+		auth.SetCurrentUser(c, principal)
+
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware mengisi principal jika request membawa token, request tanpa header
+// Authorization tetap diteruskan sebagai pengunjung. Token yang dikirim tetapi tidak valid tetap ditolak.
+func OptionalAuthMiddleware(sessions service.SessionService) gin.HandlerFunc {
+	auth := AuthMiddleware(sessions)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		auth(c)
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"go-article/pkg/apperror"
+	"go-article/pkg/utils"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler menerjemahkan error yang dikumpulkan lewat c.Error menjadi utils.Response.
+// Handler dan middleware lain cukup memanggil c.Error(err) lalu return/Abort.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		// Tidak ada error, atau response sudah ditulis oleh handler
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		appErr := apperror.From(c.Errors.Last().Err)
+
+		// Error 5xx dicatat lengkap dengan penyebabnya, client hanya menerima pesan umum
+		if appErr.Status >= http.StatusInternalServerError {
+			log.Printf("[ErrorHandler] %s %s: %v", c.Request.Method, c.Request.URL.Path, appErr)
+		}
+
+		// Error yang membawa detail retry_after (misalnya akun terkunci) ikut mengisi header Retry-After
+		if details, ok := appErr.Details.(map[string]int); ok {
+			if seconds, ok := details["retry_after"]; ok {
+				c.Header("Retry-After", strconv.Itoa(seconds))
+			}
+		}
+
+		body := utils.ErrorBody{
+			Code:    appErr.Code,
+			Details: appErr.Details,
+		}
+		response := utils.APIResponse(appErr.Message, appErr.Status, "error", nil, body)
+		c.AbortWithStatusJSON(appErr.Status, response)
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"go-article/internal/auth"
+	"go-article/pkg/apperror"
+	"go-article/pkg/ratelimit"
+	"io"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxRateLimitBodySize adalah ukuran body maksimum yang dibaca RateLimitByEmail
+const maxRateLimitBodySize = 64 << 10
+
+// rateLimitRemainingKey menyimpan sisa kuota terkecil di context ketika beberapa RateLimit dipasang pada satu route
+const rateLimitRemainingKey = "rate_limit_remaining"
+
+// RateLimitKey menentukan identitas yang dibatasi dari sebuah request.
+// String kosong berarti request tersebut tidak dihitung oleh policy ini.
+type RateLimitKey func(c *gin.Context) string
+
+// RateLimitByIP membatasi request per alamat IP client
+func RateLimitByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// RateLimitByUserID membatasi request per user yang login, atau per IP jika belum login.
+// Harus dipasang setelah AuthMiddleware atau OptionalAuthMiddleware.
+func RateLimitByUserID(c *gin.Context) string {
+	if principal, ok := auth.CurrentUser(c); ok {
+		return "user:" + strconv.FormatUint(principal.UserID, 10)
+	}
+	return RateLimitByIP(c)
+}
+
+// RateLimitByEmail membatasi request per alamat email pada field "email" di body JSON, misalnya
+// percobaan login ke satu akun dari banyak IP. Email di-hash agar tidak tersimpan apa adanya
+// di backend limiter, dan body dikembalikan utuh sehingga tetap bisa dibaca handler.
+func RateLimitByEmail(c *gin.Context) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxRateLimitBodySize))
+	if err != nil {
+		return ""
+	}
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+
+	var payload struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+
+	email := strings.ToLower(strings.TrimSpace(payload.Email))
+	if email == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(email))
+	return "email:" + hex.EncodeToString(sum[:16])
+}
+
+// RateLimit membatasi request menurut policy untuk setiap key, dan menulis header
+// RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy serta Retry-After saat ditolak.
+// Jika backend limiter tidak bisa dihubungi, request tetap diteruskan agar API tidak ikut mati.
+func RateLimit(limiter ratelimit.RateLimiter, policy ratelimit.Policy, key RateLimitKey) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity := key(c)
+		if identity == "" {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), identity, policy)
+		if err != nil {
+			log.Printf("[RateLimit] %s: %v", policy.Name, err)
+			c.Next()
+			return
+		}
+
+		// Header hanya ditimpa oleh policy yang sisa kuotanya paling sedikit
+		if remaining, exists := c.Get(rateLimitRemainingKey); !exists || result.Remaining <= remaining.(int) || !result.Allowed {
+			c.Set(rateLimitRemainingKey, result.Remaining)
+			c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+			c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset)))
+			c.Header("RateLimit-Policy", policy.String())
+		}
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			c.Error(apperror.TooManyRequests("RATE_LIMITED", "Too many requests. Please try again later."))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// ceilSeconds membulatkan durasi ke atas dalam detik, minimal 1 detik
+func ceilSeconds(d time.Duration) int {
+	return int(math.Max(1, math.Ceil(d.Seconds())))
+}
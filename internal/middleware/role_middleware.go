@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"go-article/internal/auth"
+	"go-article/internal/repository"
+	"go-article/pkg/apperror"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRoles hanya meloloskan user yang memiliki minimal satu dari role yang diberikan.
+// Middleware ini harus dipasang setelah AuthMiddleware karena membutuhkan principal di context.
+func RequireRoles(userRepository repository.UserRepository, roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentUser(c)
+		if !ok {
+			c.Error(apperror.Unauthorized("UNAUTHORIZED", "User not found in context"))
+			c.Abort()
+			return
+		}
+
+		// Role diambil dari tabel user_role, bukan dari token, agar perubahan role langsung berlaku
+		user, err := userRepository.FindByID(principal.UserID)
+		if err != nil {
+			c.Error(apperror.Unauthorized("UNAUTHORIZED", "User not found").Wrap(err))
+			c.Abort()
+			return
+		}
+
+		userRoles := make([]string, 0, len(user.Roles))
+		allowed := false
+		for _, userRole := range user.Roles {
+			userRoles = append(userRoles, userRole.Name)
+			for _, role := range roles {
+				if strings.EqualFold(userRole.Name, role) {
+					allowed = true
+				}
+			}
+		}
+
+		if !allowed {
+			c.Error(apperror.Forbidden("FORBIDDEN", "You do not have permission to access this resource"))
+			c.Abort()
+			return
+		}
+
+		// Simpan role ke context agar bisa dipakai handler berikutnya
+		c.Set("roles", userRoles)
+		c.Next()
+	}
+}
@@ -0,0 +1,645 @@
+package repository
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ArticleFilter berisi kriteria pencarian untuk daftar artikel
+type ArticleFilter struct {
+	// AuthorID membatasi hasil ke artikel milik author tertentu (0 berarti semua author)
+	AuthorID uint64
+	// Status membatasi hasil ke status tertentu (kosong berarti semua status)
+	Status string
+	// CategoryIDs membatasi hasil ke kategori tertentu, biasanya satu kategori beserta turunannya
+	CategoryIDs []uint64
+	// TagSlug membatasi hasil ke artikel yang memiliki tag tertentu
+	TagSlug string
+	Page    int
+	Limit   int
+}
+
+// ArticleRepository adalah interface yang mendefinisikan semua method untuk operasi artikel
+type ArticleRepository interface {
+	// Create membuat artikel baru dan mengembalikan artikel lengkap dengan author-nya
+	Create(article entity.ArticleEntity) (*entity.ArticleEntity, error)
+	// FindByID mencari artikel yang belum dihapus berdasarkan ID
+	FindByID(id uint64) (*entity.ArticleEntity, error)
+	// FindAll mengambil daftar artikel sesuai filter beserta total datanya
+	FindAll(filter ArticleFilter) ([]entity.ArticleEntity, int64, error)
+	// FindAllByCursor mengambil satu halaman artikel sesuai filter dengan pagination keyset,
+	// Page pada filter diabaikan dan hasMore menandakan masih ada artikel searah navigasi
+	FindAllByCursor(filter ArticleFilter, keyset *Keyset) (articles []entity.ArticleEntity, hasMore bool, err error)
+	// FindByIDs mengambil artikel yang belum dihapus berdasarkan daftar ID, urutan hasil tidak dijamin
+	FindByIDs(ids []uint64) ([]entity.ArticleEntity, error)
+	// FindBySlug mencari artikel yang belum dihapus berdasarkan slug saat ini
+	FindBySlug(slug string) (*entity.ArticleEntity, error)
+	// FindIDBySlugRedirect mencari ID artikel yang pernah memakai slug lama
+	FindIDBySlugRedirect(slug string) (uint64, error)
+	// FindTakenSlugs mengambil slug berawalan base yang sudah dipakai artikel lain, baik sebagai slug aktif maupun slug lama
+	FindTakenSlugs(base string, excludeArticleID uint64) ([]string, error)
+	// Update menyimpan perubahan artikel sekaligus mencatat revisi baru atas nama editor
+	Update(article entity.ArticleEntity, revision RevisionInfo) (*entity.ArticleEntity, error)
+	// Delete melakukan soft delete dengan mengisi kolom deleted_at
+	Delete(id uint64) error
+	// Transition mengubah status artikel dari FromStatus ke ToStatus dan mencatat riwayatnya,
+	// mengembalikan false jika status artikel sudah berubah lebih dulu oleh request lain
+	Transition(transition entity.ArticleTransitionEntity, publishedAt *time.Time) (bool, error)
+	// FindTransitions mengambil riwayat perubahan status artikel, dari yang paling lama
+	FindTransitions(articleID uint64) ([]entity.ArticleTransitionEntity, error)
+	// PublishDue menerbitkan artikel terjadwal yang published_at-nya sudah lewat dan mengembalikan ID-nya
+	PublishDue(now time.Time, limit int) ([]uint64, error)
+	// FindRevisions mengambil daftar revisi artikel dari yang paling baru beserta totalnya
+	FindRevisions(articleID uint64, page int, limit int) ([]entity.ArticleRevisionEntity, int64, error)
+	// FindRevision mencari revisi artikel berdasarkan nomor revisinya
+	FindRevision(articleID uint64, number int) (*entity.ArticleRevisionEntity, error)
+}
+
+// articleSortColumn adalah kolom urutan daftar artikel: waktu terbit, atau waktu dibuat untuk artikel yang belum pernah terbit.
+// Nilai yang sama dipakai sebagai Key pada cursor artikel.
+const articleSortColumn = "COALESCE(articles.published_at, articles.created_at)"
+
+// RevisionInfo berisi metadata revisi yang dicatat bersama perubahan artikel
+type RevisionInfo struct {
+	// EditorID adalah user yang melakukan perubahan
+	EditorID uint64
+	// RestoredFromID diisi jika perubahan berasal dari pemulihan revisi lama
+	RestoredFromID *uint64
+}
+
+// articleRepository adalah implementasi konkret dari interface ArticleRepository
+type articleRepository struct {
+	db *gorm.DB
+}
+
+// NewArticleRepository adalah constructor untuk membuat instance articleRepository baru
+func NewArticleRepository(db *gorm.DB) ArticleRepository {
+	return &articleRepository{db: db}
+}
+
+// Create membuat artikel baru
+// Parameter: article adalah data artikel yang akan disimpan
+// Return: pointer ke ArticleEntity yang sudah tersimpan beserta author-nya
+func (a *articleRepository) Create(article entity.ArticleEntity) (*entity.ArticleEntity, error) {
+	articleModel := model.Article{
+		AuthorID:    article.AuthorID,
+		CategoryID:  article.CategoryID,
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Excerpt:     article.Excerpt,
+		Body:        article.Body,
+		Status:      article.Status,
+		PublishedAt: article.PublishedAt,
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags").Create(&articleModel).Error; err != nil {
+			return err
+		}
+		if err := replaceArticleTags(tx, articleModel.ID, article.Tags); err != nil {
+			return err
+		}
+
+		// Isi awal artikel menjadi revisi pertama
+		article.ID = articleModel.ID
+		return createRevision(tx, article, RevisionInfo{EditorID: article.AuthorID})
+	})
+	if err != nil {
+		log.Println("[ArticleRepository] Create:", err)
+		return nil, err
+	}
+
+	// Ambil ulang agar relasi Author, Category dan Tags ikut terisi
+	return a.FindByID(articleModel.ID)
+}
+
+// FindByID mencari artikel berdasarkan ID dan mengabaikan artikel yang sudah di-soft delete
+// Parameter: id adalah ID artikel yang dicari
+// Return: pointer ke ArticleEntity dan error jika tidak ditemukan
+func (a *articleRepository) FindByID(id uint64) (*entity.ArticleEntity, error) {
+	var article model.Article
+	err := a.db.Where("id = ? AND deleted_at IS NULL", id).Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).First(&article).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindByID:", err)
+		return nil, err
+	}
+
+	return toArticleEntity(article), nil
+}
+
+// FindAll mengambil daftar artikel dengan pagination
+// Parameter: filter berisi kriteria author, status, halaman dan limit
+// Return: slice ArticleEntity, total data yang cocok dengan filter, dan error jika ada
+func (a *articleRepository) FindAll(filter ArticleFilter) ([]entity.ArticleEntity, int64, error) {
+	query := a.filterArticles(filter)
+
+	// Hitung total sebelum limit/offset diterapkan
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[ArticleRepository] FindAll - counting:", err)
+		return nil, 0, err
+	}
+
+	var articles []model.Article
+	err := query.Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).
+		Order(articleSortColumn + " DESC").
+		Order("id DESC").
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Find(&articles).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindAll:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.ArticleEntity, 0, len(articles))
+	for _, article := range articles {
+		result = append(result, *toArticleEntity(article))
+	}
+
+	return result, total, nil
+}
+
+// FindAllByCursor mengambil daftar artikel dengan pagination keyset pada (articleSortColumn, id)
+// Parameter: filter berisi kriteria author, status, kategori, tag dan limit; keyset adalah posisi cursor (nil untuk halaman pertama)
+// Return: slice ArticleEntity terbaru lebih dulu, hasMore jika masih ada artikel searah navigasi, dan error jika ada
+func (a *articleRepository) FindAllByCursor(filter ArticleFilter, keyset *Keyset) ([]entity.ArticleEntity, bool, error) {
+	var articles []model.Article
+	query := applyKeyset(a.filterArticles(filter), articleSortColumn, "articles.id", true, keyset, filter.Limit)
+	err := query.Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).Find(&articles).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindAllByCursor:", err)
+		return nil, false, err
+	}
+
+	articles, hasMore := keysetPage(articles, filter.Limit, keyset)
+	result := make([]entity.ArticleEntity, 0, len(articles))
+	for _, article := range articles {
+		result = append(result, *toArticleEntity(article))
+	}
+	return result, hasMore, nil
+}
+
+// FindByIDs mengambil beberapa artikel sekaligus, misalnya untuk melengkapi hasil pencarian
+// Parameter: ids adalah daftar ID artikel
+// Return: slice ArticleEntity untuk ID yang ditemukan dan error jika ada
+func (a *articleRepository) FindByIDs(ids []uint64) ([]entity.ArticleEntity, error) {
+	if len(ids) == 0 {
+		return []entity.ArticleEntity{}, nil
+	}
+
+	var articles []model.Article
+	err := a.db.Where("id IN ? AND deleted_at IS NULL", ids).Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).Find(&articles).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindByIDs:", err)
+		return nil, err
+	}
+
+	result := make([]entity.ArticleEntity, 0, len(articles))
+	for _, article := range articles {
+		result = append(result, *toArticleEntity(article))
+	}
+	return result, nil
+}
+
+// FindBySlug mencari artikel berdasarkan slug aktifnya dan mengabaikan artikel yang sudah di-soft delete
+// Parameter: slug adalah slug artikel, misalnya "belajar-golang"
+// Return: pointer ke ArticleEntity dan error jika tidak ditemukan
+func (a *articleRepository) FindBySlug(slug string) (*entity.ArticleEntity, error) {
+	var article model.Article
+	err := a.db.Where("slug = ? AND deleted_at IS NULL", slug).Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).First(&article).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindBySlug:", err)
+		return nil, err
+	}
+
+	return toArticleEntity(article), nil
+}
+
+// FindIDBySlugRedirect mencari artikel pemilik slug lama
+// Parameter: slug adalah slug yang sudah tidak dipakai karena judul artikel berubah
+// Return: ID artikel, gorm.ErrRecordNotFound jika slug tidak pernah dipakai
+func (a *articleRepository) FindIDBySlugRedirect(slug string) (uint64, error) {
+	var redirect model.ArticleSlugRedirect
+	if err := a.db.Where("slug = ?", slug).First(&redirect).Error; err != nil {
+		log.Println("[ArticleRepository] FindIDBySlugRedirect:", err)
+		return 0, err
+	}
+	return redirect.ArticleID, nil
+}
+
+// FindTakenSlugs mengambil slug yang sama dengan base atau berbentuk base-N
+// Artikel yang sudah dihapus tetap dihitung karena unique index mencakup semua baris,
+// slug lama artikel lain juga dihitung agar URL lamanya tidak berpindah ke artikel berbeda.
+// Parameter: base adalah slug dasar, excludeArticleID adalah artikel yang slug-nya boleh dipakai ulang (0 jika artikel baru)
+// Return: daftar slug yang tidak boleh dipakai
+func (a *articleRepository) FindTakenSlugs(base string, excludeArticleID uint64) ([]string, error) {
+	// Slug hanya berisi huruf kecil, angka dan tanda hubung sehingga aman dipakai di pola LIKE
+	pattern := base + "-%"
+
+	var slugs []string
+	err := a.db.Raw(
+		"SELECT slug FROM articles WHERE (slug = ? OR slug LIKE ?) AND id <> ? "+
+			"UNION SELECT slug FROM article_slug_redirects WHERE (slug = ? OR slug LIKE ?) AND article_id <> ?",
+		base, pattern, excludeArticleID, base, pattern, excludeArticleID,
+	).Scan(&slugs).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindTakenSlugs:", err)
+		return nil, err
+	}
+
+	return slugs, nil
+}
+
+// Update menyimpan perubahan judul, slug, ringkasan, isi, status dan tag artikel,
+// lalu mencatat isi barunya sebagai revisi dalam transaksi yang sama.
+// Jika slug berubah, slug lama disimpan sebagai redirect ke artikel ini.
+// Parameter: article adalah data artikel dengan ID yang sudah ada, Tags menggantikan seluruh tag lama;
+// revision berisi editor dan asal pemulihan (jika ada)
+// Return: pointer ke ArticleEntity setelah diperbarui
+func (a *articleRepository) Update(article entity.ArticleEntity, revision RevisionInfo) (*entity.ArticleEntity, error) {
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var current model.Article
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("id", "slug").
+			Where("id = ? AND deleted_at IS NULL", article.ID).
+			Take(&current).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.Article{}).
+			Where("id = ? AND deleted_at IS NULL", article.ID).
+			Updates(map[string]interface{}{
+				"category_id":  article.CategoryID,
+				"title":        article.Title,
+				"slug":         article.Slug,
+				"excerpt":      article.Excerpt,
+				"body":         article.Body,
+				"status":       article.Status,
+				"published_at": article.PublishedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("article_id = ?", article.ID).Delete(&model.ArticleTag{}).Error; err != nil {
+			return err
+		}
+		if err := replaceArticleTags(tx, article.ID, article.Tags); err != nil {
+			return err
+		}
+		if current.Slug != article.Slug {
+			if err := replaceSlugRedirect(tx, article.ID, current.Slug, article.Slug); err != nil {
+				return err
+			}
+		}
+		return createRevision(tx, article, revision)
+	})
+	if err != nil {
+		log.Println("[ArticleRepository] Update:", err)
+		return nil, err
+	}
+
+	return a.FindByID(article.ID)
+}
+
+// Delete melakukan soft delete artikel dengan mengisi kolom deleted_at
+// Parameter: id adalah ID artikel yang akan dihapus
+func (a *articleRepository) Delete(id uint64) error {
+	err := a.db.Model(&model.Article{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Update("deleted_at", time.Now()).Error
+	if err != nil {
+		log.Println("[ArticleRepository] Delete:", err)
+		return err
+	}
+
+	return nil
+}
+
+// Transition menjalankan perpindahan status secara atomik
+// Parameter: transition berisi artikel, aksi, status asal/tujuan, actor dan komentar;
+// publishedAt adalah nilai published_at setelah transisi
+// Return: true jika transisi diterapkan, false jika status artikel bukan FromStatus lagi
+func (a *articleRepository) Transition(transition entity.ArticleTransitionEntity, publishedAt *time.Time) (bool, error) {
+	applied := false
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// Kondisi status asal mencegah dua editor menyetujui/menolak artikel yang sama bersamaan
+		result := tx.Model(&model.Article{}).
+			Where("id = ? AND status = ? AND deleted_at IS NULL", transition.ArticleID, transition.FromStatus).
+			Updates(map[string]interface{}{
+				"status":       transition.ToStatus,
+				"published_at": publishedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		applied = true
+		return tx.Create(&model.ArticleTransition{
+			ArticleID:  transition.ArticleID,
+			Action:     transition.Action,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			ActorID:    transition.ActorID,
+			Comment:    transition.Comment,
+		}).Error
+	})
+	if err != nil {
+		log.Println("[ArticleRepository] Transition:", err)
+		return false, err
+	}
+
+	return applied, nil
+}
+
+// PublishDue mengubah artikel terjadwal yang sudah jatuh tempo menjadi published.
+// Baris dikunci dengan SELECT ... FOR UPDATE SKIP LOCKED sehingga beberapa replika API
+// yang berjalan bersamaan mengambil batch berbeda dan tidak menerbitkan artikel yang sama dua kali.
+// Parameter: now adalah batas waktu jadwal, limit adalah ukuran batch
+// Return: ID artikel yang diterbitkan oleh pemanggil ini
+func (a *articleRepository) PublishDue(now time.Time, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var due []model.Article
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Select("id").
+			Where("status = ? AND published_at <= ? AND deleted_at IS NULL", model.ArticleStatusScheduled, now).
+			Order("published_at ASC").
+			Limit(limit).
+			Find(&due).Error
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids = make([]uint64, 0, len(due))
+		transitions := make([]model.ArticleTransition, 0, len(due))
+		for _, article := range due {
+			ids = append(ids, article.ID)
+			transitions = append(transitions, model.ArticleTransition{
+				ArticleID:  article.ID,
+				Action:     model.ArticleActionPublish,
+				FromStatus: model.ArticleStatusScheduled,
+				ToStatus:   model.ArticleStatusPublished,
+			})
+		}
+
+		// published_at tetap berisi waktu jadwal, hanya status yang berubah
+		err = tx.Model(&model.Article{}).
+			Where("id IN ? AND status = ?", ids, model.ArticleStatusScheduled).
+			Update("status", model.ArticleStatusPublished).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&transitions).Error
+	})
+	if err != nil {
+		log.Println("[ArticleRepository] PublishDue:", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// FindTransitions mengambil riwayat perubahan status artikel beserta actor-nya
+// Parameter: articleID adalah ID artikel
+// Return: slice ArticleTransitionEntity terurut dari yang paling lama
+func (a *articleRepository) FindTransitions(articleID uint64) ([]entity.ArticleTransitionEntity, error) {
+	var transitions []model.ArticleTransition
+	err := a.db.Where("article_id = ?", articleID).
+		Preload("Actor").
+		Order("created_at ASC, id ASC").
+		Find(&transitions).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindTransitions:", err)
+		return nil, err
+	}
+
+	result := make([]entity.ArticleTransitionEntity, 0, len(transitions))
+	for _, transition := range transitions {
+		item := entity.ArticleTransitionEntity{
+			ID:         transition.ID,
+			ArticleID:  transition.ArticleID,
+			Action:     transition.Action,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			ActorID:    transition.ActorID,
+			Comment:    transition.Comment,
+			CreatedAt:  transition.CreatedAt,
+		}
+		if transition.Actor != nil {
+			item.Actor = &entity.ArticleAuthor{
+				ID:     transition.Actor.ID,
+				Name:   transition.Actor.Name,
+				Avatar: transition.Actor.Avatar,
+			}
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// FindRevisions mengambil revisi artikel beserta editor-nya dengan pagination
+// Parameter: articleID adalah ID artikel, page dan limit untuk pagination
+// Return: slice ArticleRevisionEntity dari nomor revisi terbesar, total revisi, dan error jika ada
+func (a *articleRepository) FindRevisions(articleID uint64, page int, limit int) ([]entity.ArticleRevisionEntity, int64, error) {
+	query := a.db.Model(&model.ArticleRevision{}).Where("article_id = ?", articleID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[ArticleRepository] FindRevisions - counting:", err)
+		return nil, 0, err
+	}
+
+	var revisions []model.ArticleRevision
+	err := query.Preload("Editor").
+		Order("revision_number DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&revisions).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindRevisions:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.ArticleRevisionEntity, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, *toArticleRevisionEntity(revision))
+	}
+	return result, total, nil
+}
+
+// FindRevision mencari satu revisi artikel
+// Parameter: articleID adalah ID artikel, number adalah nomor revisi (dimulai dari 1)
+// Return: pointer ke ArticleRevisionEntity dan error jika tidak ditemukan
+func (a *articleRepository) FindRevision(articleID uint64, number int) (*entity.ArticleRevisionEntity, error) {
+	var revision model.ArticleRevision
+	err := a.db.Where("article_id = ? AND revision_number = ?", articleID, number).
+		Preload("Editor").
+		First(&revision).Error
+	if err != nil {
+		log.Println("[ArticleRepository] FindRevision:", err)
+		return nil, err
+	}
+	return toArticleRevisionEntity(revision), nil
+}
+
+// createRevision menyimpan isi artikel sebagai revisi dengan nomor berikutnya.
+// Harus dipanggil setelah baris artikel ditulis dalam transaksi yang sama, sehingga row lock artikel
+// membuat perubahan paralel pada artikel yang sama mendapat nomor revisi berurutan.
+func createRevision(tx *gorm.DB, article entity.ArticleEntity, revision RevisionInfo) error {
+	var last int
+	err := tx.Model(&model.ArticleRevision{}).
+		Where("article_id = ?", article.ID).
+		Select("COALESCE(MAX(revision_number), 0)").
+		Scan(&last).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&model.ArticleRevision{
+		ArticleID:      article.ID,
+		RevisionNumber: last + 1,
+		Title:          article.Title,
+		Excerpt:        article.Excerpt,
+		Body:           article.Body,
+		EditorID:       &revision.EditorID,
+		RestoredFromID: revision.RestoredFromID,
+	}).Error
+}
+
+// replaceSlugRedirect mencatat slug lama sebagai redirect. Jika slug baru adalah slug lama artikel ini
+// (judul dikembalikan seperti semula), baris redirect-nya dihapus karena slug itu aktif lagi.
+func replaceSlugRedirect(tx *gorm.DB, articleID uint64, oldSlug string, newSlug string) error {
+	err := tx.Where("article_id = ? AND slug = ?", articleID, newSlug).Delete(&model.ArticleSlugRedirect{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Create(&model.ArticleSlugRedirect{ArticleID: articleID, Slug: oldSlug}).Error
+}
+
+// replaceArticleTags menulis ulang baris article_tag untuk artikel, tag dianggap sudah ada di tabel tags
+func replaceArticleTags(tx *gorm.DB, articleID uint64, tags []entity.ArticleTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	rows := make([]model.ArticleTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, model.ArticleTag{ArticleID: articleID, TagID: tag.ID})
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+}
+
+// filterArticles membuat query artikel yang belum dihapus sesuai filter, tanpa urutan dan pagination
+func (a *articleRepository) filterArticles(filter ArticleFilter) *gorm.DB {
+	query := a.db.Model(&model.Article{}).Where("articles.deleted_at IS NULL")
+	if filter.AuthorID != 0 {
+		query = query.Where("articles.author_id = ?", filter.AuthorID)
+	}
+	if filter.Status != "" {
+		query = query.Where("articles.status = ?", filter.Status)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where("articles.category_id IN ?", filter.CategoryIDs)
+	}
+	if filter.TagSlug != "" {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM article_tag JOIN tags ON tags.id = article_tag.tag_id WHERE article_tag.article_id = articles.id AND tags.slug = ?)",
+			filter.TagSlug,
+		)
+	}
+	return query
+}
+
+// orderTagsByName mengurutkan tag yang di-preload berdasarkan nama
+func orderTagsByName(db *gorm.DB) *gorm.DB {
+	return db.Order("tags.name ASC")
+}
+
+// toArticleEntity mengonversi model Article menjadi ArticleEntity
+func toArticleEntity(article model.Article) *entity.ArticleEntity {
+	result := &entity.ArticleEntity{
+		ID:          article.ID,
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Excerpt:     article.Excerpt,
+		Body:        article.Body,
+		Status:      article.Status,
+		AuthorID:    article.AuthorID,
+		CategoryID:  article.CategoryID,
+		PublishedAt: article.PublishedAt,
+		CreatedAt:   article.CreatedAt,
+		UpdatedAt:   article.UpdatedAt,
+		Reactions: map[string]int64{
+			model.ReactionLike:  article.LikeCount,
+			model.ReactionLove:  article.LoveCount,
+			model.ReactionLaugh: article.LaughCount,
+			model.ReactionWow:   article.WowCount,
+			model.ReactionSad:   article.SadCount,
+			model.ReactionAngry: article.AngryCount,
+		},
+		BookmarkCount: article.BookmarkCount,
+	}
+
+	// Author hanya diisi jika relasi sudah di-preload
+	if article.Author.ID != 0 {
+		result.Author = &entity.ArticleAuthor{
+			ID:     article.Author.ID,
+			Name:   article.Author.Name,
+			Avatar: article.Author.Avatar,
+		}
+	}
+
+	result.Tags = make([]entity.ArticleTag, 0, len(article.Tags))
+	for _, tag := range article.Tags {
+		result.Tags = append(result.Tags, entity.ArticleTag{ID: tag.ID, Name: tag.Name, Slug: tag.Slug})
+	}
+
+	if article.Category != nil {
+		result.Category = &entity.ArticleCategory{
+			ID:   article.Category.ID,
+			Name: article.Category.Name,
+			Slug: article.Category.Slug,
+		}
+	}
+
+	return result
+}
+
+// toArticleRevisionEntity mengonversi model ArticleRevision menjadi ArticleRevisionEntity
+func toArticleRevisionEntity(revision model.ArticleRevision) *entity.ArticleRevisionEntity {
+	result := &entity.ArticleRevisionEntity{
+		ID:             revision.ID,
+		ArticleID:      revision.ArticleID,
+		RevisionNumber: revision.RevisionNumber,
+		Title:          revision.Title,
+		Excerpt:        revision.Excerpt,
+		Body:           revision.Body,
+		EditorID:       revision.EditorID,
+		RestoredFromID: revision.RestoredFromID,
+		CreatedAt:      revision.CreatedAt,
+	}
+
+	if revision.Editor != nil {
+		result.Editor = &entity.ArticleAuthor{
+			ID:     revision.Editor.ID,
+			Name:   revision.Editor.Name,
+			Avatar: revision.Editor.Avatar,
+		}
+	}
+	return result
+}
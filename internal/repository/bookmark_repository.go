@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BookmarkRepository adalah interface yang mendefinisikan semua method untuk operasi bookmark artikel
+type BookmarkRepository interface {
+	// Add menyimpan bookmark, mengembalikan false jika artikel sudah di-bookmark
+	Add(userID uint64, articleID uint64) (bool, error)
+	// Remove menghapus bookmark, mengembalikan false jika artikel belum di-bookmark
+	Remove(userID uint64, articleID uint64) (bool, error)
+	// Exists memeriksa apakah user sudah mem-bookmark artikel
+	Exists(userID uint64, articleID uint64) (bool, error)
+	// FindArticles mengambil artikel yang di-bookmark user, dari bookmark terbaru
+	FindArticles(userID uint64, page int, limit int) ([]entity.ArticleEntity, int64, error)
+}
+
+// bookmarkRepository adalah implementasi konkret dari interface BookmarkRepository
+type bookmarkRepository struct {
+	db *gorm.DB
+}
+
+// NewBookmarkRepository adalah constructor untuk membuat instance bookmarkRepository baru
+func NewBookmarkRepository(db *gorm.DB) BookmarkRepository {
+	return &bookmarkRepository{db: db}
+}
+
+// Add menyimpan bookmark dan menaikkan bookmark_count artikel dalam satu transaksi
+// Parameter: userID dan articleID
+// Return: true jika bookmark baru ditambahkan
+func (r *bookmarkRepository) Add(userID uint64, articleID uint64) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ArticleBookmark{
+			UserID:    userID,
+			ArticleID: articleID,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		added = true
+		return adjustArticleCounter(tx, articleID, "bookmark_count", 1)
+	})
+	if err != nil {
+		log.Println("[BookmarkRepository] Add:", err)
+		return false, err
+	}
+	return added, nil
+}
+
+// Remove menghapus bookmark dan menurunkan bookmark_count artikel dalam satu transaksi
+// Parameter: userID dan articleID
+// Return: true jika bookmark dihapus
+func (r *bookmarkRepository) Remove(userID uint64, articleID uint64) (bool, error) {
+	removed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND article_id = ?", userID, articleID).Delete(&model.ArticleBookmark{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		removed = true
+		return adjustArticleCounter(tx, articleID, "bookmark_count", -1)
+	})
+	if err != nil {
+		log.Println("[BookmarkRepository] Remove:", err)
+		return false, err
+	}
+	return removed, nil
+}
+
+// Exists memeriksa apakah user sudah mem-bookmark artikel
+// Parameter: userID dan articleID
+func (r *bookmarkRepository) Exists(userID uint64, articleID uint64) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.ArticleBookmark{}).
+		Where("user_id = ? AND article_id = ?", userID, articleID).
+		Count(&count).Error
+	if err != nil {
+		log.Println("[BookmarkRepository] Exists:", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindArticles mengambil artikel yang di-bookmark user. Artikel yang sudah dihapus atau tidak lagi
+// terbit disembunyikan, kecuali artikel milik user sendiri.
+// Parameter: userID adalah pemilik bookmark, page dan limit untuk pagination
+// Return: slice ArticleEntity, total data, dan error jika ada
+func (r *bookmarkRepository) FindArticles(userID uint64, page int, limit int) ([]entity.ArticleEntity, int64, error) {
+	query := r.db.Model(&model.Article{}).
+		Joins("JOIN article_bookmarks ON article_bookmarks.article_id = articles.id AND article_bookmarks.user_id = ?", userID).
+		Where("articles.deleted_at IS NULL").
+		Where("articles.status = ? OR articles.author_id = ?", model.ArticleStatusPublished, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[BookmarkRepository] FindArticles - counting:", err)
+		return nil, 0, err
+	}
+
+	var articles []model.Article
+	err := query.Preload("Author").Preload("Category").Preload("Tags", orderTagsByName).
+		Order("article_bookmarks.created_at DESC").
+		Order("articles.id DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&articles).Error
+	if err != nil {
+		log.Println("[BookmarkRepository] FindArticles:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.ArticleEntity, 0, len(articles))
+	for _, article := range articles {
+		result = append(result, *toArticleEntity(article))
+	}
+	return result, total, nil
+}
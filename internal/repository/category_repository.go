@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"fmt"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// CategoryRepository adalah interface yang mendefinisikan semua method untuk operasi kategori
+type CategoryRepository interface {
+	// Create membuat kategori baru sekaligus mengisi path dan depth-nya
+	Create(category entity.CategoryEntity) (*entity.CategoryEntity, error)
+	// FindByID mencari kategori berdasarkan ID (tanpa children)
+	FindByID(id uint64) (*entity.CategoryEntity, error)
+	// FindBySlug mencari kategori berdasarkan slug (tanpa children)
+	FindBySlug(slug string) (*entity.CategoryEntity, error)
+	// FindTree mengambil seluruh kategori dalam bentuk pohon
+	FindTree() ([]entity.CategoryEntity, error)
+	// FindSubtree mengambil satu kategori beserta seluruh turunannya
+	FindSubtree(id uint64) (*entity.CategoryEntity, error)
+	// FindDescendantIDs mengambil ID kategori beserta ID seluruh turunannya
+	FindDescendantIDs(id uint64) ([]uint64, error)
+	// ExistsBySlug memeriksa apakah slug sudah dipakai kategori lain
+	ExistsBySlug(slug string, excludeID uint64) (bool, error)
+	// CountChildren menghitung jumlah anak langsung sebuah kategori
+	CountChildren(id uint64) (int64, error)
+	// Update menyimpan perubahan kategori dan memindahkan subtree jika parent berubah
+	Update(category entity.CategoryEntity) (*entity.CategoryEntity, error)
+	// Delete menghapus kategori secara permanen
+	Delete(id uint64) error
+}
+
+// categoryRepository adalah implementasi konkret dari interface CategoryRepository
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+// NewCategoryRepository adalah constructor untuk membuat instance categoryRepository baru
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// Create membuat kategori baru
+// Parameter: category adalah data kategori, ParentID nil berarti kategori root
+// Return: pointer ke CategoryEntity yang sudah tersimpan
+func (r *categoryRepository) Create(category entity.CategoryEntity) (*entity.CategoryEntity, error) {
+	categoryModel := model.Category{
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		Position:    category.Position,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		parentPath, depth, err := r.parentPath(tx, category.ParentID)
+		if err != nil {
+			return err
+		}
+
+		categoryModel.Path = parentPath
+		categoryModel.Depth = depth
+		if err := tx.Create(&categoryModel).Error; err != nil {
+			return err
+		}
+
+		// Path memuat ID kategori itu sendiri, jadi baru bisa diisi setelah insert
+		categoryModel.Path = fmt.Sprintf("%s%d/", parentPath, categoryModel.ID)
+		return tx.Model(&categoryModel).Update("path", categoryModel.Path).Error
+	})
+	if err != nil {
+		log.Println("[CategoryRepository] Create:", err)
+		return nil, err
+	}
+
+	return r.FindByID(categoryModel.ID)
+}
+
+// FindByID mencari kategori berdasarkan ID
+// Parameter: id adalah ID kategori yang dicari
+// Return: pointer ke CategoryEntity dan error jika tidak ditemukan
+func (r *categoryRepository) FindByID(id uint64) (*entity.CategoryEntity, error) {
+	var category model.Category
+	if err := r.db.Where("id = ?", id).First(&category).Error; err != nil {
+		log.Println("[CategoryRepository] FindByID:", err)
+		return nil, err
+	}
+	return toCategoryEntity(category), nil
+}
+
+// FindBySlug mencari kategori berdasarkan slug
+// Parameter: slug adalah slug kategori, misalnya "web"
+// Return: pointer ke CategoryEntity dan error jika tidak ditemukan
+func (r *categoryRepository) FindBySlug(slug string) (*entity.CategoryEntity, error) {
+	var category model.Category
+	if err := r.db.Where("slug = ?", slug).First(&category).Error; err != nil {
+		log.Println("[CategoryRepository] FindBySlug:", err)
+		return nil, err
+	}
+	return toCategoryEntity(category), nil
+}
+
+// FindTree mengambil seluruh kategori dengan satu query lalu menyusunnya menjadi pohon
+// Return: slice kategori root beserta children-nya
+func (r *categoryRepository) FindTree() ([]entity.CategoryEntity, error) {
+	var categories []model.Category
+	if err := r.db.Order("depth ASC, position ASC, name ASC").Find(&categories).Error; err != nil {
+		log.Println("[CategoryRepository] FindTree:", err)
+		return nil, err
+	}
+	return buildCategoryTree(categories, nil), nil
+}
+
+// FindSubtree mengambil kategori beserta seluruh turunannya memakai prefix path
+// Parameter: id adalah ID kategori yang menjadi root subtree
+// Return: pointer ke CategoryEntity dengan children terisi
+func (r *categoryRepository) FindSubtree(id uint64) (*entity.CategoryEntity, error) {
+	root, err := r.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var descendants []model.Category
+	err = r.db.Where("path LIKE ? AND id <> ?", root.Path+"%", root.ID).
+		Order("depth ASC, position ASC, name ASC").
+		Find(&descendants).Error
+	if err != nil {
+		log.Println("[CategoryRepository] FindSubtree:", err)
+		return nil, err
+	}
+
+	root.Children = buildCategoryTree(descendants, &root.ID)
+	return root, nil
+}
+
+// FindDescendantIDs mengambil ID kategori beserta seluruh turunannya
+// Parameter: id adalah ID kategori
+// Return: slice ID, selalu diawali ID kategori itu sendiri
+func (r *categoryRepository) FindDescendantIDs(id uint64) ([]uint64, error) {
+	root, err := r.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	err = r.db.Model(&model.Category{}).
+		Where("path LIKE ?", root.Path+"%").
+		Order("id ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		log.Println("[CategoryRepository] FindDescendantIDs:", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ExistsBySlug memeriksa apakah slug sudah dipakai
+// Parameter: slug adalah slug yang diperiksa, excludeID adalah ID kategori yang diabaikan (0 jika tidak ada)
+// Return: true jika slug sudah dipakai kategori lain
+func (r *categoryRepository) ExistsBySlug(slug string, excludeID uint64) (bool, error) {
+	var count int64
+	query := r.db.Model(&model.Category{}).Where("slug = ?", slug)
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		log.Println("[CategoryRepository] ExistsBySlug:", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CountChildren menghitung jumlah anak langsung sebuah kategori
+// Parameter: id adalah ID kategori parent
+func (r *categoryRepository) CountChildren(id uint64) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Category{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+		log.Println("[CategoryRepository] CountChildren:", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// Update menyimpan nama, slug, deskripsi, posisi dan parent kategori.
+// Jika parent berubah, path dan depth seluruh subtree ikut diperbarui dalam satu transaksi.
+// Parameter: category adalah data kategori dengan ID yang sudah ada
+// Return: pointer ke CategoryEntity setelah diperbarui
+func (r *categoryRepository) Update(category entity.CategoryEntity) (*entity.CategoryEntity, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var current model.Category
+		if err := tx.Where("id = ?", category.ID).First(&current).Error; err != nil {
+			return err
+		}
+
+		parentPath, depth, err := r.parentPath(tx, category.ParentID)
+		if err != nil {
+			return err
+		}
+		newPath := fmt.Sprintf("%s%d/", parentPath, current.ID)
+
+		err = tx.Model(&model.Category{}).
+			Where("id = ?", current.ID).
+			Updates(map[string]interface{}{
+				"parent_id":   category.ParentID,
+				"name":        category.Name,
+				"slug":        category.Slug,
+				"description": category.Description,
+				"position":    category.Position,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if newPath == current.Path {
+			return nil
+		}
+
+		// Ganti prefix path lama dengan path baru untuk kategori ini dan seluruh turunannya
+		return tx.Model(&model.Category{}).
+			Where("path LIKE ?", current.Path+"%").
+			Updates(map[string]interface{}{
+				"path":  gorm.Expr("CONCAT(?, SUBSTRING(path, ?))", newPath, len(current.Path)+1),
+				"depth": gorm.Expr("depth + ?", depth-current.Depth),
+			}).Error
+	})
+	if err != nil {
+		log.Println("[CategoryRepository] Update:", err)
+		return nil, err
+	}
+
+	return r.FindByID(category.ID)
+}
+
+// Delete menghapus kategori, artikel di dalamnya otomatis menjadi tanpa kategori (ON DELETE SET NULL)
+// Parameter: id adalah ID kategori yang akan dihapus
+func (r *categoryRepository) Delete(id uint64) error {
+	if err := r.db.Where("id = ?", id).Delete(&model.Category{}).Error; err != nil {
+		log.Println("[CategoryRepository] Delete:", err)
+		return err
+	}
+	return nil
+}
+
+// parentPath mengembalikan path parent dan depth untuk anak barunya
+func (r *categoryRepository) parentPath(tx *gorm.DB, parentID *uint64) (string, int, error) {
+	if parentID == nil {
+		return "/", 0, nil
+	}
+
+	var parent model.Category
+	if err := tx.Where("id = ?", *parentID).First(&parent).Error; err != nil {
+		return "", 0, err
+	}
+	return parent.Path, parent.Depth + 1, nil
+}
+
+// buildCategoryTree menyusun daftar kategori (terurut berdasarkan depth) menjadi pohon di bawah rootID
+func buildCategoryTree(categories []model.Category, rootID *uint64) []entity.CategoryEntity {
+	childrenOf := make(map[uint64][]model.Category)
+	var roots []model.Category
+	for _, category := range categories {
+		if category.ParentID == nil || (rootID != nil && *category.ParentID == *rootID) {
+			roots = append(roots, category)
+			continue
+		}
+		childrenOf[*category.ParentID] = append(childrenOf[*category.ParentID], category)
+	}
+
+	var build func(nodes []model.Category) []entity.CategoryEntity
+	build = func(nodes []model.Category) []entity.CategoryEntity {
+		result := make([]entity.CategoryEntity, 0, len(nodes))
+		for _, node := range nodes {
+			item := toCategoryEntity(node)
+			item.Children = build(childrenOf[node.ID])
+			result = append(result, *item)
+		}
+		return result
+	}
+
+	return build(roots)
+}
+
+// toCategoryEntity mengonversi model Category menjadi CategoryEntity
+func toCategoryEntity(category model.Category) *entity.CategoryEntity {
+	return &entity.CategoryEntity{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		Path:        category.Path,
+		Depth:       category.Depth,
+		Position:    category.Position,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CommentFilter berisi kriteria pencarian untuk antrean moderasi komentar
+type CommentFilter struct {
+	// Status membatasi hasil ke status tertentu (kosong berarti semua status)
+	Status string
+	// ArticleID membatasi hasil ke artikel tertentu (0 berarti semua artikel)
+	ArticleID uint64
+	Page      int
+	Limit     int
+}
+
+// CommentRepository adalah interface yang mendefinisikan semua method untuk operasi komentar
+type CommentRepository interface {
+	// Create menyimpan komentar baru dan mengembalikannya beserta user-nya
+	Create(comment entity.CommentEntity) (*entity.CommentEntity, error)
+	// FindByID mencari komentar yang belum dihapus berdasarkan ID
+	FindByID(id uint64) (*entity.CommentEntity, error)
+	// FindThreads mengambil komentar level teratas dengan pagination beserta seluruh balasannya dalam bentuk pohon
+	FindThreads(articleID uint64, viewerID uint64, page int, limit int) ([]entity.CommentEntity, int64, error)
+	// FindThreadsByCursor sama seperti FindThreads tetapi memakai pagination keyset pada (created_at, id) komentar level teratas
+	FindThreadsByCursor(articleID uint64, viewerID uint64, keyset *Keyset, limit int) (threads []entity.CommentEntity, hasMore bool, err error)
+	// FindAll mengambil daftar komentar datar untuk moderasi, dari yang paling lama
+	FindAll(filter CommentFilter) ([]entity.CommentEntity, int64, error)
+	// UpdateBody mengganti isi komentar dan mencatat waktu edit
+	UpdateBody(id uint64, body string) (*entity.CommentEntity, error)
+	// UpdateStatus mengubah status moderasi komentar
+	UpdateStatus(id uint64, status string, moderatorID uint64) (*entity.CommentEntity, error)
+	// Delete melakukan soft delete dengan mengisi kolom deleted_at
+	Delete(id uint64) error
+}
+
+// commentRepository adalah implementasi konkret dari interface CommentRepository
+type commentRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository adalah constructor untuk membuat instance commentRepository baru
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+// Create menyimpan komentar baru
+// Parameter: comment berisi artikel, parent, root, user, isi dan status awal
+// Return: pointer ke CommentEntity yang sudah tersimpan
+func (r *commentRepository) Create(comment entity.CommentEntity) (*entity.CommentEntity, error) {
+	commentModel := model.Comment{
+		ArticleID: comment.ArticleID,
+		ParentID:  comment.ParentID,
+		RootID:    comment.RootID,
+		UserID:    comment.UserID,
+		Body:      comment.Body,
+		Status:    comment.Status,
+	}
+
+	if err := r.db.Create(&commentModel).Error; err != nil {
+		log.Println("[CommentRepository] Create:", err)
+		return nil, err
+	}
+
+	return r.FindByID(commentModel.ID)
+}
+
+// FindByID mencari komentar berdasarkan ID dan mengabaikan komentar yang sudah di-soft delete
+// Parameter: id adalah ID komentar
+// Return: pointer ke CommentEntity dan error jika tidak ditemukan
+func (r *commentRepository) FindByID(id uint64) (*entity.CommentEntity, error) {
+	var comment model.Comment
+	err := r.db.Where("id = ? AND deleted_at IS NULL", id).Preload("User").Preload("Article").First(&comment).Error
+	if err != nil {
+		log.Println("[CommentRepository] FindByID:", err)
+		return nil, err
+	}
+	return toCommentEntity(comment), nil
+}
+
+// FindThreads mengambil thread komentar yang terlihat oleh viewer.
+// Komentar approved terlihat oleh semua orang, komentar pending hanya terlihat oleh penulisnya.
+// Komentar yang sudah dihapus tetap ditampilkan sebagai penanda jika masih punya balasan yang terlihat.
+// Parameter: articleID adalah ID artikel, viewerID adalah user yang melihat (0 untuk pengunjung), page dan limit untuk komentar level teratas
+// Return: slice komentar level teratas terbaru lebih dulu dengan Replies terisi, total komentar level teratas, dan error jika ada
+func (r *commentRepository) FindThreads(articleID uint64, viewerID uint64, page int, limit int) ([]entity.CommentEntity, int64, error) {
+	query := r.threadRoots(articleID, viewerID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[CommentRepository] FindThreads - counting:", err)
+		return nil, 0, err
+	}
+
+	var roots []model.Comment
+	err := query.Preload("User").
+		Order("created_at DESC, id DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&roots).Error
+	if err != nil {
+		log.Println("[CommentRepository] FindThreads:", err)
+		return nil, 0, err
+	}
+
+	threads, err := r.withReplies(roots, viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return threads, total, nil
+}
+
+// FindThreadsByCursor mengambil thread komentar yang terlihat oleh viewer dengan pagination keyset
+// Parameter: articleID adalah ID artikel, viewerID adalah user yang melihat, keyset adalah posisi cursor (nil untuk halaman pertama)
+// Return: slice komentar level teratas terbaru lebih dulu dengan Replies terisi, hasMore jika masih ada thread searah navigasi
+func (r *commentRepository) FindThreadsByCursor(articleID uint64, viewerID uint64, keyset *Keyset, limit int) ([]entity.CommentEntity, bool, error) {
+	var roots []model.Comment
+	err := applyKeyset(r.threadRoots(articleID, viewerID), "comments.created_at", "comments.id", true, keyset, limit).
+		Preload("User").
+		Find(&roots).Error
+	if err != nil {
+		log.Println("[CommentRepository] FindThreadsByCursor:", err)
+		return nil, false, err
+	}
+
+	roots, hasMore := keysetPage(roots, limit, keyset)
+	threads, err := r.withReplies(roots, viewerID)
+	if err != nil {
+		return nil, false, err
+	}
+	return threads, hasMore, nil
+}
+
+// FindAll mengambil komentar untuk antrean moderasi
+// Parameter: filter berisi status, artikel, halaman dan limit
+// Return: slice CommentEntity dari yang paling lama, total data, dan error jika ada
+func (r *commentRepository) FindAll(filter CommentFilter) ([]entity.CommentEntity, int64, error) {
+	query := r.db.Model(&model.Comment{}).Where("deleted_at IS NULL")
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ArticleID != 0 {
+		query = query.Where("article_id = ?", filter.ArticleID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[CommentRepository] FindAll - counting:", err)
+		return nil, 0, err
+	}
+
+	var comments []model.Comment
+	err := query.Preload("User").Preload("Article").
+		Order("created_at ASC, id ASC").
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Find(&comments).Error
+	if err != nil {
+		log.Println("[CommentRepository] FindAll:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.CommentEntity, 0, len(comments))
+	for _, comment := range comments {
+		result = append(result, *toCommentEntity(comment))
+	}
+	return result, total, nil
+}
+
+// UpdateBody mengganti isi komentar
+// Parameter: id adalah ID komentar, body adalah isi baru
+// Return: pointer ke CommentEntity setelah diperbarui
+func (r *commentRepository) UpdateBody(id uint64, body string) (*entity.CommentEntity, error) {
+	err := r.db.Model(&model.Comment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{"body": body, "edited_at": time.Now()}).Error
+	if err != nil {
+		log.Println("[CommentRepository] UpdateBody:", err)
+		return nil, err
+	}
+	return r.FindByID(id)
+}
+
+// UpdateStatus mengubah status moderasi komentar
+// Parameter: id adalah ID komentar, status adalah status baru, moderatorID adalah admin yang memoderasi
+// Return: pointer ke CommentEntity setelah diperbarui
+func (r *commentRepository) UpdateStatus(id uint64, status string, moderatorID uint64) (*entity.CommentEntity, error) {
+	err := r.db.Model(&model.Comment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"moderated_by": moderatorID,
+			"moderated_at": time.Now(),
+		}).Error
+	if err != nil {
+		log.Println("[CommentRepository] UpdateStatus:", err)
+		return nil, err
+	}
+	return r.FindByID(id)
+}
+
+// Delete melakukan soft delete komentar, balasannya tetap tersimpan
+// Parameter: id adalah ID komentar yang akan dihapus
+func (r *commentRepository) Delete(id uint64) error {
+	err := r.db.Model(&model.Comment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Update("deleted_at", time.Now()).Error
+	if err != nil {
+		log.Println("[CommentRepository] Delete:", err)
+		return err
+	}
+	return nil
+}
+
+// threadRoots membuat query komentar level teratas yang terlihat oleh viewer.
+// Komentar yang sudah dihapus tetap ikut jika masih punya balasan approved.
+func (r *commentRepository) threadRoots(articleID uint64, viewerID uint64) *gorm.DB {
+	return r.db.Model(&model.Comment{}).
+		Where("article_id = ? AND parent_id IS NULL", articleID).
+		Where(visibleComments(viewerID)).
+		Where(
+			"deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments replies WHERE replies.root_id = comments.id AND replies.deleted_at IS NULL AND replies.status = ?)",
+			model.CommentStatusApproved,
+		)
+}
+
+// withReplies mengambil seluruh balasan dari thread di halaman ini sekaligus lalu menyusunnya di memori
+func (r *commentRepository) withReplies(roots []model.Comment, viewerID uint64) ([]entity.CommentEntity, error) {
+	if len(roots) == 0 {
+		return []entity.CommentEntity{}, nil
+	}
+
+	rootIDs := make([]uint64, 0, len(roots))
+	for _, root := range roots {
+		rootIDs = append(rootIDs, root.ID)
+	}
+
+	var replies []model.Comment
+	err := r.db.Where("root_id IN ?", rootIDs).
+		Where(visibleComments(viewerID)).
+		Preload("User").
+		Order("created_at ASC, id ASC").
+		Find(&replies).Error
+	if err != nil {
+		log.Println("[CommentRepository] FindThreads - fetching replies:", err)
+		return nil, err
+	}
+
+	return buildCommentTree(roots, replies), nil
+}
+
+// visibleComments membatasi komentar ke yang approved atau pending milik viewer
+func visibleComments(viewerID uint64) clause.Expr {
+	return gorm.Expr("status = ? OR (status = ? AND user_id = ?)", model.CommentStatusApproved, model.CommentStatusPending, viewerID)
+}
+
+// buildCommentTree menyusun balasan di bawah komentar level teratasnya.
+// Balasan yang parent-nya tidak terlihat ikut disembunyikan, dan komentar terhapus tanpa balasan dibuang.
+func buildCommentTree(roots []model.Comment, replies []model.Comment) []entity.CommentEntity {
+	childrenOf := make(map[uint64][]model.Comment)
+	for _, reply := range replies {
+		childrenOf[*reply.ParentID] = append(childrenOf[*reply.ParentID], reply)
+	}
+
+	var build func(comment model.Comment) (entity.CommentEntity, bool)
+	build = func(comment model.Comment) (entity.CommentEntity, bool) {
+		item := *toCommentEntity(comment)
+		item.Replies = make([]entity.CommentEntity, 0, len(childrenOf[comment.ID]))
+		for _, child := range childrenOf[comment.ID] {
+			if reply, ok := build(child); ok {
+				item.Replies = append(item.Replies, reply)
+			}
+		}
+
+		if item.Deleted && len(item.Replies) == 0 {
+			return item, false
+		}
+		return item, true
+	}
+
+	result := make([]entity.CommentEntity, 0, len(roots))
+	for _, root := range roots {
+		if item, ok := build(root); ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// toCommentEntity mengonversi model Comment menjadi CommentEntity,
+// isi dan penulis komentar yang sudah dihapus dikosongkan
+func toCommentEntity(comment model.Comment) *entity.CommentEntity {
+	result := &entity.CommentEntity{
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		ParentID:    comment.ParentID,
+		RootID:      comment.RootID,
+		UserID:      comment.UserID,
+		Body:        comment.Body,
+		Status:      comment.Status,
+		Deleted:     comment.DeletedAt != nil,
+		ModeratedBy: comment.ModeratedBy,
+		ModeratedAt: comment.ModeratedAt,
+		EditedAt:    comment.EditedAt,
+		CreatedAt:   comment.CreatedAt,
+		UpdatedAt:   comment.UpdatedAt,
+	}
+
+	if result.Deleted {
+		result.Body = ""
+		result.UserID = 0
+		return result
+	}
+
+	// User dan Article hanya diisi jika relasi sudah di-preload
+	if comment.User.ID != 0 {
+		result.User = &entity.ArticleAuthor{
+			ID:     comment.User.ID,
+			Name:   comment.User.Name,
+			Avatar: comment.User.Avatar,
+		}
+	}
+	if comment.Article != nil {
+		result.Article = &entity.CommentArticle{
+			ID:    comment.Article.ID,
+			Title: comment.Article.Title,
+			Slug:  comment.Article.Slug,
+		}
+	}
+	return result
+}
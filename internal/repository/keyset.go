@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Keyset adalah posisi pagination berbasis cursor pada daftar yang diurutkan berdasarkan (Key, ID).
+// Berbeda dengan offset, query keyset tetap cepat di halaman jauh dan tidak melompati atau
+// mengulang item ketika ada data baru yang masuk di antara dua request.
+type Keyset struct {
+	// Key adalah nilai kolom urutan item terakhir yang sudah dilihat (time.Time atau string)
+	Key interface{}
+	ID  uint64
+	// Backward true mengambil item sebelum posisi ini, yaitu halaman sebelumnya
+	Backward bool
+}
+
+// applyKeyset menambahkan kondisi posisi, urutan dan limit keyset ke query.
+// column adalah ekspresi kolom urutan, desc adalah arah urutan daftar saat ditampilkan.
+// Satu item tambahan diambil untuk mengetahui apakah masih ada halaman berikutnya.
+func applyKeyset(query *gorm.DB, column string, idColumn string, desc bool, keyset *Keyset, limit int) *gorm.DB {
+	// Halaman sebelumnya dibaca dengan urutan terbalik lalu dibalik lagi oleh keysetPage
+	readDesc := desc != (keyset != nil && keyset.Backward)
+
+	if keyset != nil {
+		op := ">"
+		if readDesc {
+			op = "<"
+		}
+		query = query.Where(
+			fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, op, column, idColumn, op),
+			keyset.Key, keyset.Key, keyset.ID,
+		)
+	}
+
+	return query.
+		Order(clause.OrderByColumn{Column: clause.Column{Name: column, Raw: true}, Desc: readDesc}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: idColumn, Raw: true}, Desc: readDesc}).
+		Limit(limit + 1)
+}
+
+// keysetPage memotong item tambahan dari applyKeyset dan mengembalikan item sesuai urutan tampil.
+// hasMore bernilai true jika masih ada item lain searah navigasi.
+func keysetPage[T any](items []T, limit int, keyset *Keyset) ([]T, bool) {
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	if keyset != nil && keyset.Backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	return items, hasMore
+}
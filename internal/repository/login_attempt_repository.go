@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LoginAttemptFilter berisi kriteria pencarian audit login untuk panel admin
+type LoginAttemptFilter struct {
+	// UserID membatasi hasil ke user tertentu (0 berarti semua user)
+	UserID    uint64
+	Email     string
+	IPAddress string
+	// Result membatasi hasil ke hasil login tertentu (kosong berarti semua)
+	Result string
+	Page   int
+	Limit  int
+}
+
+// LoginAttemptRepository adalah interface untuk mencatat dan membaca audit percobaan login
+type LoginAttemptRepository interface {
+	// Create menyimpan satu catatan percobaan login
+	Create(attempt *model.LoginAttempt) error
+	// CountFailuresByIP menghitung gagal login dari satu IP sejak waktu tertentu beserta waktu gagal terakhirnya
+	CountFailuresByIP(ipAddress string, since time.Time) (count int64, last *time.Time, err error)
+	// CountUnknownEmailFailures menghitung gagal login ke email yang tidak terdaftar sejak waktu tertentu beserta waktu gagal terakhirnya
+	CountUnknownEmailFailures(email string, since time.Time) (count int64, last *time.Time, err error)
+	// FindAll mengambil daftar percobaan login terbaru sesuai filter beserta total datanya
+	FindAll(filter LoginAttemptFilter) ([]entity.LoginAttemptEntity, int64, error)
+}
+
+// loginAttemptRepository adalah implementasi konkret dari interface LoginAttemptRepository
+type loginAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginAttemptRepository adalah constructor untuk membuat instance loginAttemptRepository baru
+func NewLoginAttemptRepository(db *gorm.DB) LoginAttemptRepository {
+	return &loginAttemptRepository{db: db}
+}
+
+// Create menyimpan catatan percobaan login
+// Parameter: attempt adalah data percobaan login, ID akan terisi setelah disimpan
+func (r *loginAttemptRepository) Create(attempt *model.LoginAttempt) error {
+	if err := r.db.Create(attempt).Error; err != nil {
+		log.Println("[LoginAttemptRepository] Create:", err)
+		return err
+	}
+	return nil
+}
+
+// CountFailuresByIP menghitung percobaan login dengan password salah dari satu IP
+// Parameter: ipAddress adalah IP client, since adalah awal jendela waktu yang dihitung
+// Return: jumlah gagal login, waktu gagal terakhir (nil jika tidak ada) dan error jika ada
+func (r *loginAttemptRepository) CountFailuresByIP(ipAddress string, since time.Time) (int64, *time.Time, error) {
+	var result struct {
+		Count int64
+		Last  *time.Time
+	}
+	err := r.db.Model(&model.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last").
+		Where("ip_address = ? AND result = ? AND created_at >= ?", ipAddress, model.LoginResultInvalidCredentials, since).
+		Scan(&result).Error
+	if err != nil {
+		log.Println("[LoginAttemptRepository] CountFailuresByIP:", err)
+		return 0, nil, err
+	}
+	return result.Count, result.Last, nil
+}
+
+// CountUnknownEmailFailures menghitung percobaan login dengan password salah ke email yang tidak punya akun
+// Parameter: email adalah email yang sudah dinormalisasi seperti yang disimpan di login_attempts,
+// since adalah awal jendela waktu yang dihitung
+// Return: jumlah gagal login, waktu gagal terakhir (nil jika tidak ada) dan error jika ada
+func (r *loginAttemptRepository) CountUnknownEmailFailures(email string, since time.Time) (int64, *time.Time, error) {
+	var result struct {
+		Count int64
+		Last  *time.Time
+	}
+	err := r.db.Model(&model.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last").
+		Where("email = ? AND user_id IS NULL AND result = ? AND created_at >= ?", email, model.LoginResultInvalidCredentials, since).
+		Scan(&result).Error
+	if err != nil {
+		log.Println("[LoginAttemptRepository] CountUnknownEmailFailures:", err)
+		return 0, nil, err
+	}
+	return result.Count, result.Last, nil
+}
+
+// FindAll mengambil daftar percobaan login dari yang terbaru dengan filter dan pagination
+// Parameter: filter berisi kriteria pencarian dari panel admin
+// Return: slice LoginAttemptEntity, total data yang cocok dengan filter, dan error jika ada
+func (r *loginAttemptRepository) FindAll(filter LoginAttemptFilter) ([]entity.LoginAttemptEntity, int64, error) {
+	query := r.db.Model(&model.LoginAttempt{})
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Email != "" {
+		query = query.Where("email = ?", filter.Email)
+	}
+	if filter.IPAddress != "" {
+		query = query.Where("ip_address = ?", filter.IPAddress)
+	}
+	if filter.Result != "" {
+		query = query.Where("result = ?", filter.Result)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[LoginAttemptRepository] FindAll - counting:", err)
+		return nil, 0, err
+	}
+
+	var attempts []model.LoginAttempt
+	err := query.Order("created_at DESC").Order("id DESC").
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Find(&attempts).Error
+	if err != nil {
+		log.Println("[LoginAttemptRepository] FindAll:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.LoginAttemptEntity, 0, len(attempts))
+	for _, attempt := range attempts {
+		result = append(result, entity.LoginAttemptEntity{
+			ID:        attempt.ID,
+			UserID:    attempt.UserID,
+			Email:     attempt.Email,
+			IPAddress: attempt.IPAddress,
+			UserAgent: attempt.UserAgent,
+			Result:    attempt.Result,
+			CreatedAt: attempt.CreatedAt,
+		})
+	}
+	return result, total, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PasswordResetRepository adalah interface untuk menyimpan token reset password
+type PasswordResetRepository interface {
+	// Create menyimpan token reset baru (hanya hash-nya yang disimpan)
+	Create(token *model.PasswordResetToken) error
+	// FindByHash mencari token reset berdasarkan hash SHA-256
+	FindByHash(tokenHash string) (*model.PasswordResetToken, error)
+	// MarkUsed menandai token sudah dipakai, return false jika token sudah dipakai sebelumnya
+	MarkUsed(id uint64) (bool, error)
+	// InvalidateByUser menandai semua token reset yang belum dipakai milik user sebagai terpakai
+	InvalidateByUser(userID uint64) error
+}
+
+// passwordResetRepository adalah implementasi konkret dari interface PasswordResetRepository
+type passwordResetRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetRepository adalah constructor untuk membuat instance passwordResetRepository baru
+func NewPasswordResetRepository(db *gorm.DB) PasswordResetRepository {
+	return &passwordResetRepository{db: db}
+}
+
+// Create menyimpan token reset password baru
+// Parameter: token adalah data token, ID akan terisi setelah disimpan
+func (p *passwordResetRepository) Create(token *model.PasswordResetToken) error {
+	if err := p.db.Create(token).Error; err != nil {
+		log.Println("[PasswordResetRepository] Create:", err)
+		return err
+	}
+	return nil
+}
+
+// FindByHash mencari token reset berdasarkan hash-nya
+// Parameter: tokenHash adalah hash SHA-256 dari token yang dikirim ke email
+// Return: pointer ke model.PasswordResetToken dan error jika tidak ditemukan
+func (p *passwordResetRepository) FindByHash(tokenHash string) (*model.PasswordResetToken, error) {
+	var token model.PasswordResetToken
+	if err := p.db.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		log.Println("[PasswordResetRepository] FindByHash:", err)
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkUsed menandai token sudah dipakai secara atomik
+// Parameter: id adalah ID token reset
+// Return: true jika request ini yang pertama kali memakai token tersebut
+func (p *passwordResetRepository) MarkUsed(id uint64) (bool, error) {
+	// Update bersyarat mencegah token yang sama dipakai dua kali secara bersamaan
+	result := p.db.Model(&model.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		log.Println("[PasswordResetRepository] MarkUsed:", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// InvalidateByUser menandai semua token reset yang masih aktif milik user sebagai terpakai
+// Parameter: userID adalah pemilik token
+func (p *passwordResetRepository) InvalidateByUser(userID uint64) error {
+	err := p.db.Model(&model.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+	if err != nil {
+		log.Println("[PasswordResetRepository] InvalidateByUser:", err)
+		return err
+	}
+	return nil
+}
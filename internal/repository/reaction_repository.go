@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReactionRepository adalah interface yang mendefinisikan semua method untuk operasi reaksi artikel
+type ReactionRepository interface {
+	// Add menyimpan reaksi user, mengembalikan false jika reaksi yang sama sudah ada
+	Add(userID uint64, articleID uint64, reaction string) (bool, error)
+	// Remove menghapus reaksi user, mengembalikan false jika reaksi memang belum ada
+	Remove(userID uint64, articleID uint64, reaction string) (bool, error)
+	// FindByUser mengambil reaksi yang diberikan user pada artikel
+	FindByUser(userID uint64, articleID uint64) ([]string, error)
+}
+
+// reactionRepository adalah implementasi konkret dari interface ReactionRepository
+type reactionRepository struct {
+	db *gorm.DB
+}
+
+// NewReactionRepository adalah constructor untuk membuat instance reactionRepository baru
+func NewReactionRepository(db *gorm.DB) ReactionRepository {
+	return &reactionRepository{db: db}
+}
+
+// Add menyimpan reaksi dan menaikkan counter artikel dalam satu transaksi.
+// Counter hanya dinaikkan jika baris benar-benar ter-insert, sehingga request ganda
+// atau paralel untuk reaksi yang sama tidak menghitung dua kali.
+// Parameter: userID, articleID dan reaction yang sudah divalidasi
+// Return: true jika reaksi baru ditambahkan
+func (r *reactionRepository) Add(userID uint64, articleID uint64, reaction string) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ArticleReaction{
+			UserID:    userID,
+			ArticleID: articleID,
+			Reaction:  reaction,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		added = true
+		return adjustArticleCounter(tx, articleID, model.ReactionCountColumns[reaction], 1)
+	})
+	if err != nil {
+		log.Println("[ReactionRepository] Add:", err)
+		return false, err
+	}
+	return added, nil
+}
+
+// Remove menghapus reaksi dan menurunkan counter artikel dalam satu transaksi
+// Parameter: userID, articleID dan reaction yang sudah divalidasi
+// Return: true jika reaksi dihapus
+func (r *reactionRepository) Remove(userID uint64, articleID uint64, reaction string) (bool, error) {
+	removed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND article_id = ? AND reaction = ?", userID, articleID, reaction).
+			Delete(&model.ArticleReaction{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		removed = true
+		return adjustArticleCounter(tx, articleID, model.ReactionCountColumns[reaction], -1)
+	})
+	if err != nil {
+		log.Println("[ReactionRepository] Remove:", err)
+		return false, err
+	}
+	return removed, nil
+}
+
+// FindByUser mengambil reaksi user pada artikel
+// Parameter: userID dan articleID
+// Return: slice nama reaksi
+func (r *reactionRepository) FindByUser(userID uint64, articleID uint64) ([]string, error) {
+	var reactions []string
+	err := r.db.Model(&model.ArticleReaction{}).
+		Where("user_id = ? AND article_id = ?", userID, articleID).
+		Order("created_at ASC").
+		Pluck("reaction", &reactions).Error
+	if err != nil {
+		log.Println("[ReactionRepository] FindByUser:", err)
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// adjustArticleCounter menambah atau mengurangi counter artikel secara atomik di database.
+// updated_at ditulis dengan nilainya sendiri agar ON UPDATE CURRENT_TIMESTAMP tidak mengubah waktu edit artikel.
+func adjustArticleCounter(tx *gorm.DB, articleID uint64, column string, delta int) error {
+	query := tx.Model(&model.Article{}).Where("id = ?", articleID)
+	if delta < 0 {
+		// Counter tidak pernah negatif meskipun data sempat tidak sinkron
+		query = query.Where(column+" >= ?", -delta)
+	}
+
+	return query.UpdateColumns(map[string]interface{}{
+		column:       gorm.Expr(column+" + ?", delta),
+		"updated_at": gorm.Expr("updated_at"),
+	}).Error
+}
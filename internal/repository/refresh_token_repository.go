@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepository adalah interface untuk menyimpan dan mencabut refresh token
+type RefreshTokenRepository interface {
+	// Create menyimpan refresh token baru (hanya hash-nya yang disimpan)
+	Create(token *model.RefreshToken) error
+	// FindByHash mencari refresh token berdasarkan hash SHA-256 token
+	FindByHash(tokenHash string) (*model.RefreshToken, error)
+	// Rotate mencabut token lama dan menyimpan token penggantinya dalam satu transaksi
+	Rotate(oldID uint64, newToken *model.RefreshToken) (bool, error)
+	// RevokeFamily mencabut semua token dalam satu family (satu sesi login)
+	RevokeFamily(familyID string) error
+	// RevokeAllByUser mencabut semua refresh token milik user
+	RevokeAllByUser(userID uint64) error
+}
+
+// refreshTokenRepository adalah implementasi konkret dari interface RefreshTokenRepository
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository adalah constructor untuk membuat instance refreshTokenRepository baru
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// Create menyimpan refresh token baru
+// Parameter: token adalah data token, ID akan terisi setelah disimpan
+func (r *refreshTokenRepository) Create(token *model.RefreshToken) error {
+	if err := r.db.Create(token).Error; err != nil {
+		log.Println("[RefreshTokenRepository] Create:", err)
+		return err
+	}
+	return nil
+}
+
+// FindByHash mencari refresh token berdasarkan hash-nya, termasuk token yang sudah dicabut
+// Parameter: tokenHash adalah hash SHA-256 dari token opaque
+// Return: pointer ke model.RefreshToken dan error jika tidak ditemukan
+func (r *refreshTokenRepository) FindByHash(tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	if err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		log.Println("[RefreshTokenRepository] FindByHash:", err)
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Rotate mencabut token lama lalu menyimpan token baru sebagai penggantinya
+// Parameter: oldID adalah token yang sedang dipakai, newToken adalah token pengganti
+// Return: false jika token lama ternyata sudah dicabut (dipakai ulang atau rotasi bersamaan)
+func (r *refreshTokenRepository) Rotate(oldID uint64, newToken *model.RefreshToken) (bool, error) {
+	rotated := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Update bersyarat agar hanya satu request yang berhasil merotasi token yang sama
+		result := tx.Model(&model.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldID).
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(newToken).Error; err != nil {
+			return err
+		}
+
+		rotated = true
+		return tx.Model(&model.RefreshToken{}).
+			Where("id = ?", oldID).
+			Update("replaced_by_id", newToken.ID).Error
+	})
+	if err != nil {
+		log.Println("[RefreshTokenRepository] Rotate:", err)
+		return false, err
+	}
+
+	return rotated, nil
+}
+
+// RevokeFamily mencabut semua token aktif dalam satu family
+// Parameter: familyID adalah ID family yang dibuat saat login
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	err := r.db.Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		log.Println("[RefreshTokenRepository] RevokeFamily:", err)
+		return err
+	}
+	return nil
+}
+
+// RevokeAllByUser mencabut semua token aktif milik user
+// Parameter: userID adalah pemilik token
+func (r *refreshTokenRepository) RevokeAllByUser(userID uint64) error {
+	err := r.db.Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		log.Println("[RefreshTokenRepository] RevokeAllByUser:", err)
+		return err
+	}
+	return nil
+}
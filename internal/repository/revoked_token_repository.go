@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RevokedTokenRepository adalah interface untuk denylist jti access token
+type RevokedTokenRepository interface {
+	// Create memasukkan jti ke denylist, jti yang sudah ada diabaikan
+	Create(token *model.RevokedToken) error
+	// IsRevoked menandakan jti ada di denylist
+	IsRevoked(jti string) (bool, error)
+	// DeleteExpired menghapus jti yang token-nya sudah kedaluwarsa
+	DeleteExpired(before time.Time) (int64, error)
+}
+
+// revokedTokenRepository adalah implementasi konkret dari interface RevokedTokenRepository
+type revokedTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRevokedTokenRepository adalah constructor untuk membuat instance revokedTokenRepository baru
+func NewRevokedTokenRepository(db *gorm.DB) RevokedTokenRepository {
+	return &revokedTokenRepository{db: db}
+}
+
+// Create menyimpan jti yang dicabut, logout dua kali dengan token yang sama tidak dianggap error
+// Parameter: token berisi jti, pemilik dan waktu kedaluwarsa access token
+func (r *revokedTokenRepository) Create(token *model.RevokedToken) error {
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(token).Error; err != nil {
+		log.Println("[RevokedTokenRepository] Create:", err)
+		return err
+	}
+	return nil
+}
+
+// IsRevoked memeriksa apakah jti ada di denylist
+// Parameter: jti adalah claim jti access token
+// Return: true jika token sudah dicabut
+func (r *revokedTokenRepository) IsRevoked(jti string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&model.RevokedToken{}).Where("jti = ?", jti).Limit(1).Count(&count).Error; err != nil {
+		log.Println("[RevokedTokenRepository] IsRevoked:", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// DeleteExpired menghapus baris yang token-nya sudah kedaluwarsa karena token tersebut ditolak oleh claim exp
+// Parameter: before adalah batas waktu kedaluwarsa
+// Return: jumlah baris yang dihapus dan error jika gagal
+func (r *revokedTokenRepository) DeleteExpired(before time.Time) (int64, error) {
+	result := r.db.Where("expires_at < ?", before).Delete(&model.RevokedToken{})
+	if result.Error != nil {
+		log.Println("[RevokedTokenRepository] DeleteExpired:", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// RoleRepository adalah interface yang mendefinisikan semua method untuk operasi role
+type RoleRepository interface {
+	// FindAll mengambil semua role yang tersedia
+	FindAll() ([]model.Role, error)
+	// FindByID mencari role berdasarkan ID
+	FindByID(id uint64) (*model.Role, error)
+	// FindByName mencari role berdasarkan nama
+	FindByName(name string) (*model.Role, error)
+}
+
+// roleRepository adalah implementasi konkret dari interface RoleRepository
+type roleRepository struct {
+	db *gorm.DB
+}
+
+// NewRoleRepository adalah constructor untuk membuat instance roleRepository baru
+func NewRoleRepository(db *gorm.DB) RoleRepository {
+	return &roleRepository{db: db}
+}
+
+// FindAll mengambil semua role diurutkan berdasarkan ID
+// Return: slice dari model.Role dan error jika ada
+func (r *roleRepository) FindAll() ([]model.Role, error) {
+	var roles []model.Role
+	if err := r.db.Order("id ASC").Find(&roles).Error; err != nil {
+		log.Println("[RoleRepository] FindAll:", err)
+		return nil, err
+	}
+	return roles, nil
+}
+
+// FindByID mencari role berdasarkan ID
+// Parameter: id adalah ID role yang dicari
+// Return: pointer ke model.Role dan error jika tidak ditemukan
+func (r *roleRepository) FindByID(id uint64) (*model.Role, error) {
+	var role model.Role
+	if err := r.db.Where("id = ?", id).First(&role).Error; err != nil {
+		log.Println("[RoleRepository] FindByID:", err)
+		return nil, err
+	}
+	return &role, nil
+}
+
+// FindByName mencari role berdasarkan nama
+// Parameter: name adalah nama role, misalnya "User" atau "Admin"
+// Return: pointer ke model.Role dan error jika tidak ditemukan
+func (r *roleRepository) FindByName(name string) (*model.Role, error) {
+	var role model.Role
+	if err := r.db.Where("name = ?", name).First(&role).Error; err != nil {
+		log.Println("[RoleRepository] FindByName:", err)
+		return nil, err
+	}
+	return &role, nil
+}
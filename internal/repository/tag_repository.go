@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagFilter berisi kriteria pencarian untuk daftar tag
+type TagFilter struct {
+	// Search mencari tag yang nama atau slug-nya diawali kata kunci (untuk autocomplete)
+	Search string
+	Page   int
+	Limit  int
+}
+
+// TagRepository adalah interface yang mendefinisikan semua method untuk operasi tag
+type TagRepository interface {
+	// FindOrCreate mengambil tag berdasarkan slug dan membuat tag yang belum ada
+	FindOrCreate(tags []entity.TagEntity) ([]entity.TagEntity, error)
+	// FindAll mengambil daftar tag beserta jumlah pemakaiannya, diurutkan dari yang paling populer
+	FindAll(filter TagFilter) ([]entity.TagEntity, int64, error)
+	// FindByID mencari tag berdasarkan ID
+	FindByID(id uint64) (*entity.TagEntity, error)
+	// FindBySlug mencari tag berdasarkan slug
+	FindBySlug(slug string) (*entity.TagEntity, error)
+	// Rename mengganti nama dan slug tag, relasi artikel tidak berubah karena memakai tag_id
+	Rename(id uint64, name string, slug string) (*entity.TagEntity, error)
+	// Merge memindahkan semua artikel dari tag sumber ke tag tujuan lalu menghapus tag sumber
+	Merge(sourceID uint64, targetID uint64) (*entity.TagEntity, error)
+	// Delete menghapus tag beserta relasinya ke artikel
+	Delete(id uint64) error
+	// FindPublishedArticleIDs mengambil ID artikel terbit yang memakai tag, misalnya untuk diindeks ulang
+	FindPublishedArticleIDs(id uint64) ([]uint64, error)
+}
+
+// tagRepository adalah implementasi konkret dari interface TagRepository
+type tagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository adalah constructor untuk membuat instance tagRepository baru
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+// FindOrCreate memastikan semua tag ada di database
+// Parameter: tags adalah daftar tag dengan Name dan Slug yang sudah dinormalisasi
+// Return: tag yang tersimpan dengan urutan sesuai input
+func (r *tagRepository) FindOrCreate(tags []entity.TagEntity) ([]entity.TagEntity, error) {
+	if len(tags) == 0 {
+		return []entity.TagEntity{}, nil
+	}
+
+	rows := make([]model.Tag, 0, len(tags))
+	slugs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, model.Tag{Name: tag.Name, Slug: tag.Slug})
+		slugs = append(slugs, tag.Slug)
+	}
+
+	// Slug yang sudah ada dilewati sehingga request paralel dengan tag baru yang sama tidak gagal
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error; err != nil {
+		log.Println("[TagRepository] FindOrCreate - inserting:", err)
+		return nil, err
+	}
+
+	var existing []model.Tag
+	if err := r.db.Where("slug IN ?", slugs).Find(&existing).Error; err != nil {
+		log.Println("[TagRepository] FindOrCreate - fetching:", err)
+		return nil, err
+	}
+
+	bySlug := make(map[string]model.Tag, len(existing))
+	for _, tag := range existing {
+		bySlug[tag.Slug] = tag
+	}
+
+	result := make([]entity.TagEntity, 0, len(slugs))
+	for _, slug := range slugs {
+		if tag, ok := bySlug[slug]; ok {
+			result = append(result, *toTagEntity(tag, 0))
+		}
+	}
+	return result, nil
+}
+
+// FindAll mengambil daftar tag dengan jumlah artikel yang memakainya
+// Parameter: filter berisi kata kunci, halaman dan limit
+// Return: slice TagEntity, total tag yang cocok, dan error jika ada
+func (r *tagRepository) FindAll(filter TagFilter) ([]entity.TagEntity, int64, error) {
+	query := r.db.Model(&model.Tag{})
+	if filter.Search != "" {
+		keyword := filter.Search + "%"
+		query = query.Where("tags.name LIKE ? OR tags.slug LIKE ?", keyword, keyword)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[TagRepository] FindAll - counting:", err)
+		return nil, 0, err
+	}
+
+	var rows []tagWithCount
+	err := query.
+		Select("tags.*, COUNT(articles.id) AS article_count").
+		Joins("LEFT JOIN article_tag ON article_tag.tag_id = tags.id").
+		Joins("LEFT JOIN articles ON articles.id = article_tag.article_id AND articles.deleted_at IS NULL").
+		Group("tags.id").
+		Order("article_count DESC").
+		Order("tags.name ASC").
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		log.Println("[TagRepository] FindAll:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.TagEntity, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toTagEntity(row.Tag, row.ArticleCount))
+	}
+	return result, total, nil
+}
+
+// FindByID mencari tag berdasarkan ID beserta jumlah pemakaiannya
+// Parameter: id adalah ID tag yang dicari
+// Return: pointer ke TagEntity dan error jika tidak ditemukan
+func (r *tagRepository) FindByID(id uint64) (*entity.TagEntity, error) {
+	return r.findOne("tags.id = ?", id)
+}
+
+// FindBySlug mencari tag berdasarkan slug beserta jumlah pemakaiannya
+// Parameter: slug adalah slug tag yang dicari
+// Return: pointer ke TagEntity dan error jika tidak ditemukan
+func (r *tagRepository) FindBySlug(slug string) (*entity.TagEntity, error) {
+	return r.findOne("tags.slug = ?", slug)
+}
+
+// Rename mengganti nama dan slug tag
+// Parameter: id adalah ID tag, name dan slug adalah nilai baru
+// Return: pointer ke TagEntity setelah diperbarui
+func (r *tagRepository) Rename(id uint64, name string, slug string) (*entity.TagEntity, error) {
+	err := r.db.Model(&model.Tag{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"name": name, "slug": slug}).Error
+	if err != nil {
+		log.Println("[TagRepository] Rename:", err)
+		return nil, err
+	}
+	return r.FindByID(id)
+}
+
+// Merge menggabungkan tag sumber ke tag tujuan dalam satu transaksi
+// Parameter: sourceID adalah tag yang akan dihapus, targetID adalah tag yang dipertahankan
+// Return: pointer ke TagEntity tujuan dengan jumlah pemakaian terbaru
+func (r *tagRepository) Merge(sourceID uint64, targetID uint64) (*entity.TagEntity, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Artikel yang sudah punya kedua tag dilewati agar primary key tidak bentrok
+		err := tx.Exec(
+			"INSERT IGNORE INTO article_tag (article_id, tag_id, created_at) SELECT article_id, ?, created_at FROM article_tag WHERE tag_id = ?",
+			targetID, sourceID,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("tag_id = ?", sourceID).Delete(&model.ArticleTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", sourceID).Delete(&model.Tag{}).Error
+	})
+	if err != nil {
+		log.Println("[TagRepository] Merge:", err)
+		return nil, err
+	}
+	return r.FindByID(targetID)
+}
+
+// Delete menghapus tag, baris article_tag ikut terhapus lewat ON DELETE CASCADE
+// Parameter: id adalah ID tag yang akan dihapus
+func (r *tagRepository) Delete(id uint64) error {
+	if err := r.db.Where("id = ?", id).Delete(&model.Tag{}).Error; err != nil {
+		log.Println("[TagRepository] Delete:", err)
+		return err
+	}
+	return nil
+}
+
+// FindPublishedArticleIDs mengambil ID artikel terbit yang belum dihapus dan memakai tag tertentu
+// Parameter: id adalah ID tag
+// Return: slice ID artikel dan error jika ada
+func (r *tagRepository) FindPublishedArticleIDs(id uint64) ([]uint64, error) {
+	var ids []uint64
+	err := r.db.Model(&model.ArticleTag{}).
+		Joins("JOIN articles ON articles.id = article_tag.article_id").
+		Where("article_tag.tag_id = ? AND articles.status = ? AND articles.deleted_at IS NULL", id, model.ArticleStatusPublished).
+		Pluck("article_tag.article_id", &ids).Error
+	if err != nil {
+		log.Println("[TagRepository] FindPublishedArticleIDs:", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// tagWithCount menampung hasil query tag yang digabung dengan jumlah artikelnya
+type tagWithCount struct {
+	model.Tag
+	ArticleCount int64
+}
+
+// findOne mengambil satu tag sesuai kondisi beserta jumlah artikelnya
+func (r *tagRepository) findOne(condition string, value interface{}) (*entity.TagEntity, error) {
+	var row tagWithCount
+	err := r.db.Model(&model.Tag{}).
+		Select("tags.*, COUNT(articles.id) AS article_count").
+		Joins("LEFT JOIN article_tag ON article_tag.tag_id = tags.id").
+		Joins("LEFT JOIN articles ON articles.id = article_tag.article_id AND articles.deleted_at IS NULL").
+		Where(condition, value).
+		Group("tags.id").
+		Take(&row).Error
+	if err != nil {
+		log.Println("[TagRepository] findOne:", err)
+		return nil, err
+	}
+	return toTagEntity(row.Tag, row.ArticleCount), nil
+}
+
+// toTagEntity mengonversi model Tag menjadi TagEntity
+func toTagEntity(tag model.Tag, articleCount int64) *entity.TagEntity {
+	return &entity.TagEntity{
+		ID:           tag.ID,
+		Name:         tag.Name,
+		Slug:         tag.Slug,
+		ArticleCount: articleCount,
+		CreatedAt:    tag.CreatedAt,
+		UpdatedAt:    tag.UpdatedAt,
+	}
+}
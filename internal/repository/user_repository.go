@@ -4,10 +4,32 @@ import (
 	"go-article/internal/domain/entity"
 	"go-article/internal/domain/model"
 	"log"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// UserFilter berisi kriteria pencarian daftar user untuk panel admin
+type UserFilter struct {
+	// Search mencari berdasarkan nama atau email (LIKE)
+	Search string
+	// Role membatasi hasil ke user yang memiliki role dengan nama tertentu
+	Role string
+	// Verified membatasi hasil berdasarkan status verifikasi email (nil berarti semua)
+	Verified *bool
+	// Status membatasi hasil ke status akun tertentu (active/suspended)
+	Status string
+	// Deleted menentukan user terhapus: "without" (default), "with", atau "only"
+	Deleted string
+	// SortBy adalah kolom pengurutan (name, email, created_at), SortDesc untuk urutan menurun
+	SortBy   string
+	SortDesc bool
+	Page     int
+	Limit    int
+}
+
 // UserRepository adalah interface yang mendefinisikan semua method untuk operasi user
 type UserRepository interface {
 	// Create membuat user baru, return pointer ke UserEntity agar tidak ada copy data
@@ -20,6 +42,42 @@ type UserRepository interface {
 	FindByID(id uint64) (*entity.UserEntity, error)
 	// GetRolesByIDs mengambil daftar role berdasarkan ID-ID yang diberikan
 	GetRolesByIDs(roleIDs []uint64) ([]model.Role, error)
+	// GrantRole menambahkan role ke user dan mencatat siapa yang memberikannya
+	GrantRole(userID uint64, roleID uint64, grantedBy uint64) error
+	// RevokeRole mencabut role dari user
+	RevokeRole(userID uint64, roleID uint64) error
+	// MarkVerified mengisi kolom verify_at dengan waktu verifikasi email
+	MarkVerified(id uint64, verifiedAt time.Time) error
+	// UpdatePassword mengganti hash password user
+	UpdatePassword(id uint64, hashedPassword string) error
+	// UpdateProfile menyimpan perubahan nama dan avatar user
+	UpdateProfile(id uint64, name string, avatar *string) error
+	// SoftDelete menandai user sebagai terhapus dengan mengisi kolom deleted_at
+	SoftDelete(id uint64) error
+	// FindAll mengambil daftar user sesuai filter beserta total datanya
+	FindAll(filter UserFilter) ([]entity.UserEntity, int64, error)
+	// FindAllByCursor mengambil satu halaman user sesuai filter dengan pagination keyset pada kolom sort dan id,
+	// Page pada filter diabaikan dan hasMore menandakan masih ada user searah navigasi
+	FindAllByCursor(filter UserFilter, keyset *Keyset) (users []entity.UserEntity, hasMore bool, err error)
+	// FindByIDWithDeleted mencari user berdasarkan ID termasuk yang sudah di-soft delete
+	FindByIDWithDeleted(id uint64) (*entity.UserEntity, error)
+	// UpdateByAdmin menyimpan perubahan nama, email dan avatar user oleh admin,
+	// resetVerification mengosongkan verify_at karena email baru belum diverifikasi
+	UpdateByAdmin(id uint64, name string, email string, avatar *string, resetVerification bool) error
+	// Restore mengembalikan user yang sudah di-soft delete
+	Restore(id uint64) error
+	// UpdateStatus mengubah status akun user (active/suspended)
+	UpdateStatus(id uint64, status string) error
+	// RecordLoginFailure menambah counter gagal login secara atomik dan mengembalikan nilai barunya
+	RecordLoginFailure(id uint64) (uint, error)
+	// LockUntil mengunci akun dari login sampai waktu tertentu
+	LockUntil(id uint64, until time.Time) error
+	// ResetLoginFailures mengosongkan counter gagal login dan membuka kunci akun
+	ResetLoginFailures(id uint64) error
+	// FindSessionState mengambil status dan versi token user yang belum dihapus
+	FindSessionState(id uint64) (*entity.UserSessionState, error)
+	// IncrementTokenVersion menaikkan token_version sehingga semua access token user ditolak
+	IncrementTokenVersion(id uint64) error
 }
 
 // userRepository adalah implementasi konkret dari interface UserRepository
@@ -44,6 +102,7 @@ func (u *userRepository) CreateWithRoles(user entity.UserEntity, roleIDs []uint6
 		Email:    user.Email,    // Salin email dari entity ke model
 		Password: user.Password, // Salin password dari entity ke model
 		Avatar:   user.Avatar,   // Salin avatar dari entity ke model
+		Status:   model.UserStatusActive,
 	}
 
 	// Buat user di database menggunakan GORM Create
@@ -76,12 +135,14 @@ func (u *userRepository) CreateWithRoles(user entity.UserEntity, roleIDs []uint6
 
 	// Konversi User model kembali ke UserEntity dengan data lengkap termasuk Roles
 	result := &entity.UserEntity{
-		ID:       userModel.ID,       // ID yang di-generate oleh database
-		Name:     userModel.Name,     // Nama user
-		Email:    userModel.Email,    // Email user
-		Password: userModel.Password, // Password user (akan di-hide di JSON response)
-		Avatar:   userModel.Avatar,   // Avatar URL (bisa nil)
-		Roles:    userModel.Roles,    // Daftar role yang terkait dengan user
+		ID:        userModel.ID,        // ID yang di-generate oleh database
+		Name:      userModel.Name,      // Nama user
+		Email:     userModel.Email,     // Email user
+		Password:  userModel.Password,  // Password user (akan di-hide di JSON response)
+		Avatar:    userModel.Avatar,    // Avatar URL (bisa nil)
+		Status:    userModel.Status,    // Status akun (active)
+		Roles:     userModel.Roles,     // Daftar role yang terkait dengan user
+		CreatedAt: userModel.CreatedAt, // Waktu registrasi
 	}
 
 	// Return pointer ke UserEntity (bukan value copy) - ini lebih efisien secara memory
@@ -94,8 +155,8 @@ func (u *userRepository) CreateWithRoles(user entity.UserEntity, roleIDs []uint6
 func (u *userRepository) FindByID(id uint64) (*entity.UserEntity, error) {
 	// Deklarasi variabel user dengan tipe model.User
 	var user model.User
-	// Query database untuk mencari user dengan ID tertentu dan preload Roles-nya
-	err := u.db.Where("id = ?", id).Preload("Roles").First(&user).Error
+	// Query database untuk mencari user dengan ID tertentu yang belum dihapus dan preload Roles-nya
+	err := u.db.Where("id = ? AND deleted_at IS NULL", id).Preload("Roles").First(&user).Error
 	if err != nil {
 		// Jika error (user tidak ditemukan atau error database), log error dan return nil
 		log.Println("[UserRepository] FindByID:", err)
@@ -104,10 +165,18 @@ func (u *userRepository) FindByID(id uint64) (*entity.UserEntity, error) {
 
 	// Konversi User model ke UserEntity dan return sebagai pointer
 	return &entity.UserEntity{
-		ID:    user.ID,    // ID user dari database
-		Name:  user.Name,  // Nama user
-		Email: user.Email, // Email user
-		Roles: user.Roles, // Role-role yang terkait (sudah di-preload dari database)
+		ID:                  user.ID,                     // ID user dari database
+		Name:                user.Name,                   // Nama user
+		Email:               user.Email,                  // Email user
+		Password:            user.Password,               // Password user (untuk verifikasi ganti password, di-hide di JSON)
+		Avatar:              user.Avatar,                 // Avatar URL
+		VerifiedAt:          formatTime(user.VerifiedAt), // Waktu verifikasi email (nil jika belum)
+		Status:              user.Status,                 // Status akun (active/suspended)
+		FailedLoginAttempts: user.FailedLoginAttempts,    // Jumlah gagal login berturut-turut
+		LockedUntil:         user.LockedUntil,            // Batas waktu akun terkunci (nil jika tidak terkunci)
+		TokenVersion:        user.TokenVersion,           // Versi token untuk access token baru
+		Roles:               user.Roles,                  // Role-role yang terkait (sudah di-preload dari database)
+		CreatedAt:           user.CreatedAt,              // Waktu registrasi
 	}, nil
 }
 
@@ -121,6 +190,7 @@ func (u *userRepository) Create(user entity.UserEntity) (*entity.UserEntity, err
 		Email:    user.Email,    // Salin email dari entity ke model
 		Password: user.Password, // Salin password (sudah di-hash sebelumnya)
 		Avatar:   user.Avatar,   // Salin avatar jika ada
+		Status:   model.UserStatusActive,
 	}
 
 	// Buat user di database menggunakan GORM Create
@@ -133,12 +203,14 @@ func (u *userRepository) Create(user entity.UserEntity) (*entity.UserEntity, err
 
 	// Konversi User model yang sudah disimpan kembali ke UserEntity
 	result := &entity.UserEntity{
-		ID:       userModel.ID,       // ID yang di-generate oleh database
-		Name:     userModel.Name,     // Nama user
-		Email:    userModel.Email,    // Email user
-		Password: userModel.Password, // Password user (akan di-hide di JSON response)
-		Avatar:   userModel.Avatar,   // Avatar URL
-		Roles:    userModel.Roles,    // Roles (kosong karena tidak ada yang di-associate)
+		ID:        userModel.ID,        // ID yang di-generate oleh database
+		Name:      userModel.Name,      // Nama user
+		Email:     userModel.Email,     // Email user
+		Password:  userModel.Password,  // Password user (akan di-hide di JSON response)
+		Avatar:    userModel.Avatar,    // Avatar URL
+		Status:    userModel.Status,    // Status akun (active)
+		Roles:     userModel.Roles,     // Roles (kosong karena tidak ada yang di-associate)
+		CreatedAt: userModel.CreatedAt, // Waktu registrasi
 	}
 
 	// Return pointer ke UserEntity baru - efisien karena tidak ada copy data yang besar
@@ -151,8 +223,8 @@ func (u *userRepository) Create(user entity.UserEntity) (*entity.UserEntity, err
 func (u *userRepository) FindByEmail(email string) (*entity.UserEntity, error) {
 	// Deklarasi variabel user dengan tipe model.User
 	var user model.User
-	// Query database untuk mencari user dengan email tertentu dan preload Roles-nya
-	err := u.db.Where("email = ?", email).Preload("Roles").First(&user).Error
+	// Query database untuk mencari user dengan email tertentu yang belum dihapus dan preload Roles-nya
+	err := u.db.Where("email = ? AND deleted_at IS NULL", email).Preload("Roles").First(&user).Error
 	if err != nil {
 		// Jika error (user tidak ditemukan atau error database), log error dan return nil
 		log.Println("[UserRepository] FindByEmail:", err)
@@ -161,12 +233,18 @@ func (u *userRepository) FindByEmail(email string) (*entity.UserEntity, error) {
 
 	// Konversi User model ke UserEntity dan return sebagai pointer
 	return &entity.UserEntity{
-		ID:       user.ID,       // ID user dari database
-		Name:     user.Name,     // Nama user
-		Email:    user.Email,    // Email user
-		Password: user.Password, // Password user (untuk keperluan verifikasi di service)
-		Avatar:   user.Avatar,   // Avatar URL
-		Roles:    user.Roles,    // Role-role yang terkait dengan user
+		ID:                  user.ID,                     // ID user dari database
+		Name:                user.Name,                   // Nama user
+		Email:               user.Email,                  // Email user
+		Password:            user.Password,               // Password user (untuk keperluan verifikasi di service)
+		Avatar:              user.Avatar,                 // Avatar URL
+		VerifiedAt:          formatTime(user.VerifiedAt), // Waktu verifikasi email (nil jika belum)
+		Status:              user.Status,                 // Status akun (untuk menolak login user yang di-suspend)
+		FailedLoginAttempts: user.FailedLoginAttempts,    // Jumlah gagal login berturut-turut (untuk backoff)
+		LockedUntil:         user.LockedUntil,            // Batas waktu akun terkunci (untuk menolak login sementara)
+		TokenVersion:        user.TokenVersion,           // Versi token untuk access token baru
+		Roles:               user.Roles,                  // Role-role yang terkait dengan user
+		CreatedAt:           user.CreatedAt,              // Waktu registrasi
 	}, nil
 }
 
@@ -186,3 +264,367 @@ func (u *userRepository) GetRolesByIDs(roleIDs []uint64) ([]model.Role, error) {
 	// Return slice roles yang berhasil diambil dari database
 	return roles, nil
 }
+
+// GrantRole menambahkan role ke user beserta ID admin yang memberikannya
+// Parameter: userID adalah penerima role, roleID adalah role yang diberikan, grantedBy adalah ID admin
+// Return: error jika gagal, grant yang sudah ada dianggap berhasil (idempotent)
+func (u *userRepository) GrantRole(userID uint64, roleID uint64, grantedBy uint64) error {
+	// Simpan relasi ke tabel user_role langsung agar kolom granted_by ikut terisi
+	userRole := model.UserRole{
+		UserID:    userID,
+		RoleID:    roleID,
+		GrantedBy: &grantedBy,
+	}
+
+	// Jika user sudah memiliki role tersebut, abaikan tanpa error
+	err := u.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&userRole).Error
+	if err != nil {
+		log.Println("[UserRepository] GrantRole:", err)
+		return err
+	}
+	return nil
+}
+
+// RevokeRole menghapus relasi user dengan role tertentu dari tabel user_role
+// Parameter: userID adalah user yang dicabut role-nya, roleID adalah role yang dicabut
+// Return: error jika gagal
+func (u *userRepository) RevokeRole(userID uint64, roleID uint64) error {
+	err := u.db.Where("user_id = ? AND role_id = ?", userID, roleID).Delete(&model.UserRole{}).Error
+	if err != nil {
+		log.Println("[UserRepository] RevokeRole:", err)
+		return err
+	}
+	return nil
+}
+
+// MarkVerified menandai email user sudah terverifikasi
+// Parameter: id adalah ID user, verifiedAt adalah waktu verifikasi
+// Return: error jika gagal
+func (u *userRepository) MarkVerified(id uint64, verifiedAt time.Time) error {
+	// Hanya update jika belum pernah diverifikasi agar waktu verifikasi pertama tidak tertimpa
+	err := u.db.Model(&model.User{}).
+		Where("id = ? AND verify_at IS NULL", id).
+		Update("verify_at", verifiedAt).Error
+	if err != nil {
+		log.Println("[UserRepository] MarkVerified:", err)
+		return err
+	}
+	return nil
+}
+
+// UpdatePassword mengganti password user dengan hash yang baru
+// Parameter: id adalah ID user, hashedPassword adalah password yang sudah di-hash dengan bcrypt
+// Return: error jika gagal
+func (u *userRepository) UpdatePassword(id uint64, hashedPassword string) error {
+	err := u.db.Model(&model.User{}).Where("id = ?", id).Update("password", hashedPassword).Error
+	if err != nil {
+		log.Println("[UserRepository] UpdatePassword:", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateProfile menyimpan perubahan nama dan avatar user yang belum dihapus
+// Parameter: id adalah ID user, name adalah nama baru, avatar adalah URL avatar baru (nil untuk menghapus)
+// Return: error jika gagal
+func (u *userRepository) UpdateProfile(id uint64, name string, avatar *string) error {
+	err := u.db.Model(&model.User{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"name":   name,
+			"avatar": avatar,
+		}).Error
+	if err != nil {
+		log.Println("[UserRepository] UpdateProfile:", err)
+		return err
+	}
+	return nil
+}
+
+// SoftDelete menghapus user secara soft delete, data tetap ada di database
+// Parameter: id adalah ID user yang akan dihapus
+// Return: error jika gagal
+func (u *userRepository) SoftDelete(id uint64) error {
+	err := u.db.Model(&model.User{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Update("deleted_at", time.Now()).Error
+	if err != nil {
+		log.Println("[UserRepository] SoftDelete:", err)
+		return err
+	}
+	return nil
+}
+
+// FindAll mengambil daftar user dengan pencarian, filter, pengurutan dan pagination
+// Parameter: filter berisi kriteria pencarian dari panel admin
+// Return: slice UserEntity, total data yang cocok dengan filter, dan error jika ada
+func (u *userRepository) FindAll(filter UserFilter) ([]entity.UserEntity, int64, error) {
+	query := u.filterUsers(filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Println("[UserRepository] FindAll - counting:", err)
+		return nil, 0, err
+	}
+
+	var users []model.User
+	err := query.Preload("Roles").
+		Order(clause.OrderByColumn{Column: clause.Column{Name: userSortColumn(filter.SortBy), Raw: true}, Desc: filter.SortDesc}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "users.id"}, Desc: filter.SortDesc}).
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Find(&users).Error
+	if err != nil {
+		log.Println("[UserRepository] FindAll:", err)
+		return nil, 0, err
+	}
+
+	result := make([]entity.UserEntity, 0, len(users))
+	for _, user := range users {
+		result = append(result, *toUserEntity(user))
+	}
+
+	return result, total, nil
+}
+
+// FindAllByCursor mengambil daftar user dengan pagination keyset pada (kolom sort, id)
+// Parameter: filter berisi kriteria pencarian dan urutan dari panel admin, keyset adalah posisi cursor (nil untuk halaman pertama)
+// Return: slice UserEntity, hasMore jika masih ada user searah navigasi, dan error jika ada
+func (u *userRepository) FindAllByCursor(filter UserFilter, keyset *Keyset) ([]entity.UserEntity, bool, error) {
+	var users []model.User
+	query := applyKeyset(u.filterUsers(filter), userSortColumn(filter.SortBy), "users.id", filter.SortDesc, keyset, filter.Limit)
+	if err := query.Preload("Roles").Find(&users).Error; err != nil {
+		log.Println("[UserRepository] FindAllByCursor:", err)
+		return nil, false, err
+	}
+
+	users, hasMore := keysetPage(users, filter.Limit, keyset)
+	result := make([]entity.UserEntity, 0, len(users))
+	for _, user := range users {
+		result = append(result, *toUserEntity(user))
+	}
+	return result, hasMore, nil
+}
+
+// filterUsers membuat query user sesuai filter panel admin, tanpa urutan dan pagination
+func (u *userRepository) filterUsers(filter UserFilter) *gorm.DB {
+	query := u.db.Model(&model.User{})
+
+	// Filter user terhapus, default hanya user yang belum dihapus
+	switch filter.Deleted {
+	case "only":
+		query = query.Where("users.deleted_at IS NOT NULL")
+	case "with":
+	default:
+		query = query.Where("users.deleted_at IS NULL")
+	}
+
+	if filter.Search != "" {
+		keyword := "%" + escapeLike(filter.Search) + "%"
+		query = query.Where(`(users.name LIKE ? ESCAPE '\\' OR users.email LIKE ? ESCAPE '\\')`, keyword, keyword)
+	}
+	if filter.Role != "" {
+		// Pakai EXISTS agar user dengan banyak role tidak muncul dobel
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM user_role ur JOIN roles r ON r.id = ur.role_id
+			WHERE ur.user_id = users.id AND r.name = ?)`, filter.Role)
+	}
+	if filter.Verified != nil {
+		if *filter.Verified {
+			query = query.Where("users.verify_at IS NOT NULL")
+		} else {
+			query = query.Where("users.verify_at IS NULL")
+		}
+	}
+	if filter.Status != "" {
+		query = query.Where("users.status = ?", filter.Status)
+	}
+	return query
+}
+
+// userSortColumn memetakan nama sort ke kolom, dibatasi whitelist agar aman dari SQL injection
+func userSortColumn(sortBy string) string {
+	switch sortBy {
+	case "name":
+		return "users.name"
+	case "email":
+		return "users.email"
+	default:
+		return "users.created_at"
+	}
+}
+
+// FindByIDWithDeleted mencari user berdasarkan ID tanpa mengabaikan user yang sudah dihapus
+// Parameter: id adalah ID user yang dicari
+// Return: pointer ke UserEntity dan error jika tidak ditemukan
+func (u *userRepository) FindByIDWithDeleted(id uint64) (*entity.UserEntity, error) {
+	var user model.User
+	err := u.db.Where("id = ?", id).Preload("Roles").First(&user).Error
+	if err != nil {
+		log.Println("[UserRepository] FindByIDWithDeleted:", err)
+		return nil, err
+	}
+
+	return toUserEntity(user), nil
+}
+
+// UpdateByAdmin menyimpan perubahan data user yang dilakukan oleh admin
+// Parameter: id adalah ID user, name/email/avatar adalah nilai baru, resetVerification true jika email berganti
+// Return: error jika gagal (misalnya email sudah dipakai user lain)
+func (u *userRepository) UpdateByAdmin(id uint64, name string, email string, avatar *string, resetVerification bool) error {
+	updates := map[string]interface{}{
+		"name":   name,
+		"email":  email,
+		"avatar": avatar,
+	}
+	if resetVerification {
+		updates["verify_at"] = nil
+	}
+
+	err := u.db.Model(&model.User{}).Where("id = ?", id).Updates(updates).Error
+	if err != nil {
+		log.Println("[UserRepository] UpdateByAdmin:", err)
+		return err
+	}
+	return nil
+}
+
+// Restore mengembalikan user yang sudah di-soft delete dengan mengosongkan deleted_at
+// Parameter: id adalah ID user yang akan dikembalikan
+// Return: error jika gagal
+func (u *userRepository) Restore(id uint64) error {
+	err := u.db.Model(&model.User{}).Where("id = ?", id).Update("deleted_at", nil).Error
+	if err != nil {
+		log.Println("[UserRepository] Restore:", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateStatus mengubah status akun user
+// Parameter: id adalah ID user, status adalah model.UserStatusActive atau model.UserStatusSuspended
+// Return: error jika gagal
+func (u *userRepository) UpdateStatus(id uint64, status string) error {
+	err := u.db.Model(&model.User{}).Where("id = ?", id).Update("status", status).Error
+	if err != nil {
+		log.Println("[UserRepository] UpdateStatus:", err)
+		return err
+	}
+	return nil
+}
+
+// RecordLoginFailure menambah failed_login_attempts dalam satu transaksi lalu membaca nilainya,
+// baris user terkunci selama transaksi sehingga percobaan login bersamaan tetap terhitung semua
+// Parameter: id adalah ID user yang gagal login
+// Return: jumlah gagal login berturut-turut setelah ditambah dan error jika gagal
+func (u *userRepository) RecordLoginFailure(id uint64) (uint, error) {
+	var attempts uint
+	err := u.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.User{}).
+			Where("id = ?", id).
+			Update("failed_login_attempts", gorm.Expr("failed_login_attempts + 1")).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&model.User{}).Where("id = ?", id).Pluck("failed_login_attempts", &attempts).Error
+	})
+	if err != nil {
+		log.Println("[UserRepository] RecordLoginFailure:", err)
+		return 0, err
+	}
+	return attempts, nil
+}
+
+// LockUntil mengisi locked_until sehingga login ditolak sampai waktu tersebut
+// Parameter: id adalah ID user, until adalah batas waktu penguncian
+// Return: error jika gagal
+func (u *userRepository) LockUntil(id uint64, until time.Time) error {
+	err := u.db.Model(&model.User{}).Where("id = ?", id).Update("locked_until", until).Error
+	if err != nil {
+		log.Println("[UserRepository] LockUntil:", err)
+		return err
+	}
+	return nil
+}
+
+// ResetLoginFailures mengembalikan counter gagal login ke nol dan mengosongkan locked_until
+// Parameter: id adalah ID user yang berhasil login atau dibuka kuncinya oleh admin
+// Return: error jika gagal
+func (u *userRepository) ResetLoginFailures(id uint64) error {
+	err := u.db.Model(&model.User{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"failed_login_attempts": 0,
+			"locked_until":          nil,
+		}).Error
+	if err != nil {
+		log.Println("[UserRepository] ResetLoginFailures:", err)
+		return err
+	}
+	return nil
+}
+
+// FindSessionState hanya membaca kolom yang dibutuhkan untuk memeriksa access token
+// Parameter: id adalah ID user pada claim sub
+// Return: pointer ke UserSessionState dan gorm.ErrRecordNotFound jika user tidak ada atau sudah dihapus
+func (u *userRepository) FindSessionState(id uint64) (*entity.UserSessionState, error) {
+	var state entity.UserSessionState
+	err := u.db.Model(&model.User{}).
+		Select("id", "status", "token_version").
+		Where("id = ? AND deleted_at IS NULL", id).
+		Take(&state).Error
+	if err != nil {
+		log.Println("[UserRepository] FindSessionState:", err)
+		return nil, err
+	}
+	return &state, nil
+}
+
+// IncrementTokenVersion menaikkan token_version secara atomik
+// Parameter: id adalah ID user yang semua access token-nya dicabut
+// Return: error jika gagal
+func (u *userRepository) IncrementTokenVersion(id uint64) error {
+	err := u.db.Model(&model.User{}).
+		Where("id = ?", id).
+		Update("token_version", gorm.Expr("token_version + 1")).Error
+	if err != nil {
+		log.Println("[UserRepository] IncrementTokenVersion:", err)
+		return err
+	}
+	return nil
+}
+
+// toUserEntity mengonversi model User menjadi UserEntity lengkap
+func toUserEntity(user model.User) *entity.UserEntity {
+	return &entity.UserEntity{
+		ID:                  user.ID,
+		Name:                user.Name,
+		Email:               user.Email,
+		Password:            user.Password,
+		Avatar:              user.Avatar,
+		VerifiedAt:          formatTime(user.VerifiedAt),
+		Status:              user.Status,
+		FailedLoginAttempts: user.FailedLoginAttempts,
+		LockedUntil:         user.LockedUntil,
+		TokenVersion:        user.TokenVersion,
+		Roles:               user.Roles,
+		CreatedAt:           user.CreatedAt,
+		DeletedAt:           user.DeletedAt,
+	}
+}
+
+// escapeLike meng-escape karakter wildcard LIKE (% dan _) serta backslash agar kata kunci dicari apa adanya
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// formatTime mengubah *time.Time menjadi *string berformat RFC3339 untuk UserEntity
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
@@ -1,29 +1,219 @@
 package routes
 
 import (
+	"go-article/internal/domain/model"
 	"go-article/internal/handler"
 	"go-article/internal/middleware"
 	"go-article/internal/repository"
+	"go-article/internal/search"
 	"go-article/internal/service"
+	"go-article/pkg/keyring"
+	"go-article/pkg/mailer"
+	"go-article/pkg/ratelimit"
+	"go-article/pkg/validation"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
-func SetupRoutes(db *gorm.DB) *gin.Engine {
+func SetupRoutes(db *gorm.DB, publishNotifier *service.PublishNotifier, searchIndex search.SearchIndex, sessionService service.SessionService, keys *keyring.Keyring) *gin.Engine {
+	validation.Setup()
+
 	r := gin.Default()
+	r.Use(middleware.ErrorHandler())
 
 	// Dependency injections
 	userRepository := repository.NewUserRepository(db)
-	authService := service.NewAuthService(userRepository)
+	roleRepository := repository.NewRoleRepository(db)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db)
+	passwordResetRepository := repository.NewPasswordResetRepository(db)
+	loginAttemptRepository := repository.NewLoginAttemptRepository(db)
+	mail := mailer.NewFromEnv()
+	authService := service.NewAuthService(userRepository, roleRepository, refreshTokenRepository, passwordResetRepository, loginAttemptRepository, sessionService, mail)
 	authHandler := handler.NewAuthHandler(authService)
 
+	articleRepository := repository.NewArticleRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	articleService := service.NewArticleService(articleRepository, userRepository, categoryRepository, tagRepository, searchIndex)
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	articleWorkflowService := service.NewArticleWorkflowService(articleRepository, userRepository, publishNotifier, searchIndex)
+	articleWorkflowHandler := handler.NewArticleWorkflowHandler(articleWorkflowService)
+
+	articleRevisionService := service.NewArticleRevisionService(articleRepository, searchIndex)
+	articleRevisionHandler := handler.NewArticleRevisionHandler(articleRevisionService)
+
+	searchService := service.NewSearchService(searchIndex, articleRepository)
+	searchHandler := handler.NewSearchHandler(searchService)
+
+	commentRepository := repository.NewCommentRepository(db)
+	commentService := service.NewCommentService(commentRepository, articleRepository, userRepository)
+	commentHandler := handler.NewCommentHandler(commentService)
+
+	reactionRepository := repository.NewReactionRepository(db)
+	bookmarkRepository := repository.NewBookmarkRepository(db)
+	engagementService := service.NewEngagementService(articleRepository, reactionRepository, bookmarkRepository)
+	engagementHandler := handler.NewEngagementHandler(engagementService)
+
+	categoryService := service.NewCategoryService(categoryRepository, articleRepository)
+	categoryHandler := handler.NewCategoryHandler(categoryService)
+
+	tagService := service.NewTagService(tagRepository, articleRepository, searchIndex)
+	tagHandler := handler.NewTagHandler(tagService)
+
+	userService := service.NewUserService(userRepository, sessionService)
+	userHandler := handler.NewUserHandler(userService)
+
+	adminUserService := service.NewAdminUserService(userRepository, sessionService, loginAttemptRepository)
+	adminUserHandler := handler.NewAdminUserHandler(adminUserService)
+
+	roleService := service.NewRoleService(userRepository, roleRepository)
+	roleHandler := handler.NewRoleHandler(roleService)
+
+	jwksHandler := handler.NewJWKSHandler(keys)
+
+	// Rate limit per route, batas bawaan bisa diganti lewat env RATE_LIMIT_<NAMA_POLICY>
+	limiter := ratelimit.NewFromEnv()
+	limit := func(name string, max int, window time.Duration, key middleware.RateLimitKey) gin.HandlerFunc {
+		return middleware.RateLimit(limiter, ratelimit.PolicyFromEnv(name, max, window), key)
+	}
+	registerLimit := limit("auth-register", 10, time.Hour, middleware.RateLimitByIP)
+	loginLimit := limit("auth-login", 20, time.Minute, middleware.RateLimitByIP)
+	loginEmailLimit := limit("auth-login-email", 5, time.Minute, middleware.RateLimitByEmail)
+	refreshLimit := limit("auth-refresh", 30, time.Minute, middleware.RateLimitByIP)
+	emailLimit := limit("auth-email", 10, 15*time.Minute, middleware.RateLimitByIP)
+	emailRecipientLimit := limit("auth-email-recipient", 3, 15*time.Minute, middleware.RateLimitByEmail)
+	resetPasswordLimit := limit("auth-reset-password", 10, 15*time.Minute, middleware.RateLimitByIP)
+	commentLimit := limit("comments", 10, time.Minute, middleware.RateLimitByUserID)
+
+	// Access token diperiksa ke sessionService agar token yang dicabut langsung ditolak
+	requireAuth := middleware.AuthMiddleware(sessionService)
+	optionalAuth := middleware.OptionalAuthMiddleware(sessionService)
+
+	// Kunci publik JWT untuk service lain (Public)
+	r.GET("/.well-known/jwks.json", jwksHandler.Show)
+
 	// Auth Routes (Public)
 	auth := r.Group("/auth")
 	{
-		auth.POST("/register", middleware.RateLimitByIP(), authHandler.Register)
-		auth.POST("/login", middleware.RateLimitByIP(), authHandler.Login)
-		auth.GET("/profile", middleware.AuthMiddleware(), authHandler.Profile)
+		auth.POST("/register", registerLimit, authHandler.Register)
+		auth.POST("/login", loginLimit, loginEmailLimit, authHandler.Login)
+		auth.POST("/refresh", refreshLimit, authHandler.Refresh)
+		auth.POST("/logout", authHandler.Logout)
+		auth.GET("/verify", authHandler.VerifyEmail)
+		auth.POST("/verify", authHandler.VerifyEmail)
+		auth.POST("/resend-verification", emailLimit, emailRecipientLimit, authHandler.ResendVerification)
+		auth.POST("/forgot-password", emailLimit, emailRecipientLimit, authHandler.ForgotPassword)
+		auth.POST("/reset-password", resetPasswordLimit, authHandler.ResetPassword)
+		auth.POST("/logout-all", requireAuth, authHandler.LogoutAll)
+		auth.GET("/profile", requireAuth, authHandler.Profile)
+	}
+
+	// User Self-Service Routes (Protected)
+	users := r.Group("/users", requireAuth)
+	{
+		users.GET("/me", userHandler.Profile)
+		users.PATCH("/me", userHandler.UpdateProfile)
+		users.PUT("/me/password", userHandler.ChangePassword)
+		users.DELETE("/me", userHandler.DeleteAccount)
+		users.GET("/me/bookmarks", engagementHandler.Bookmarks)
+	}
+
+	// Article Routes (Protected)
+	articles := r.Group("/articles", requireAuth)
+	{
+		articles.POST("", articleHandler.Create)
+		articles.GET("", articleHandler.List)
+		articles.PUT("/:id", articleHandler.Update)
+		articles.DELETE("/:id", articleHandler.Delete)
+		articles.POST("/:id/submit", articleWorkflowHandler.Submit)
+		articles.POST("/:id/archive", articleWorkflowHandler.Archive)
+		articles.POST("/:id/revise", articleWorkflowHandler.Revise)
+		articles.GET("/:id/history", articleWorkflowHandler.History)
+		articles.GET("/:id/revisions", articleRevisionHandler.List)
+		articles.GET("/:id/revisions/diff", articleRevisionHandler.Diff)
+		articles.GET("/:id/revisions/:revision", articleRevisionHandler.Show)
+		articles.POST("/:id/revisions/:revision/restore", articleRevisionHandler.Restore)
+		articles.POST("/:id/comments", commentLimit, commentHandler.Create)
+		articles.PUT("/:id/comments/:comment_id", commentHandler.Update)
+		articles.DELETE("/:id/comments/:comment_id", commentHandler.Delete)
+		articles.PUT("/:id/reactions/:reaction", engagementHandler.React)
+		articles.DELETE("/:id/reactions/:reaction", engagementHandler.Unreact)
+		articles.PUT("/:id/bookmark", engagementHandler.Bookmark)
+		articles.DELETE("/:id/bookmark", engagementHandler.Unbookmark)
+	}
+
+	// Article Search (Public, hanya artikel yang sudah terbit)
+	r.GET("/articles/search", searchHandler.Articles)
+
+	// Article Detail & Comments (Public, :id bisa berupa ID atau slug, login opsional untuk melihat draft, komentar pending atau reaksi sendiri)
+	r.GET("/articles/:id", optionalAuth, articleHandler.Show)
+	r.GET("/articles/:id/comments", optionalAuth, commentHandler.List)
+	r.GET("/articles/:id/reactions", optionalAuth, engagementHandler.Summary)
+
+	// Editor Routes (Protected, role Editor atau Admin)
+	editor := r.Group("/editor", requireAuth, middleware.RequireRoles(userRepository, model.RoleEditor, model.RoleAdmin))
+	{
+		editor.GET("/articles", articleWorkflowHandler.Queue)
+		editor.GET("/articles/:id", articleWorkflowHandler.Show)
+		editor.GET("/articles/:id/history", articleWorkflowHandler.HistoryAsEditor)
+		editor.POST("/articles/:id/approve", articleWorkflowHandler.Approve)
+		editor.POST("/articles/:id/reject", articleWorkflowHandler.Reject)
+		editor.POST("/articles/:id/publish", articleWorkflowHandler.Publish)
+		editor.POST("/articles/:id/archive", articleWorkflowHandler.ArchiveAsEditor)
+	}
+
+	// Category Routes (Public)
+	categories := r.Group("/categories")
+	{
+		categories.GET("", categoryHandler.Tree)
+		categories.GET("/:slug/articles", categoryHandler.Articles)
+	}
+
+	// Tag Routes (Public)
+	tags := r.Group("/tags")
+	{
+		tags.GET("", tagHandler.List)
+		tags.GET("/autocomplete", tagHandler.Autocomplete)
+	}
+
+	// Admin Routes (Protected, role Admin)
+	admin := r.Group("/admin", requireAuth, middleware.RequireRoles(userRepository, model.RoleAdmin))
+	{
+		admin.DELETE("/articles/:id", articleHandler.ForceDelete)
+
+		admin.GET("/users", adminUserHandler.List)
+		admin.GET("/users/:id", adminUserHandler.Show)
+		admin.PATCH("/users/:id", adminUserHandler.Update)
+		admin.DELETE("/users/:id", adminUserHandler.Delete)
+		admin.POST("/users/:id/restore", adminUserHandler.Restore)
+		admin.POST("/users/:id/suspend", adminUserHandler.Suspend)
+		admin.POST("/users/:id/activate", adminUserHandler.Activate)
+		admin.POST("/users/:id/unlock", adminUserHandler.Unlock)
+		admin.POST("/users/:id/sign-out", adminUserHandler.SignOut)
+		admin.GET("/login-attempts", adminUserHandler.LoginAttempts)
+
+		admin.GET("/categories", categoryHandler.Tree)
+		admin.POST("/categories", categoryHandler.Create)
+		admin.GET("/categories/:id", categoryHandler.Show)
+		admin.PUT("/categories/:id", categoryHandler.Update)
+		admin.DELETE("/categories/:id", categoryHandler.Delete)
+
+		admin.PATCH("/tags/:id", tagHandler.Rename)
+		admin.POST("/tags/:id/merge", tagHandler.Merge)
+		admin.DELETE("/tags/:id", tagHandler.Delete)
+
+		admin.GET("/comments", commentHandler.Queue)
+		admin.POST("/comments/:id/approve", commentHandler.Approve)
+		admin.POST("/comments/:id/reject", commentHandler.Reject)
+		admin.POST("/comments/:id/spam", commentHandler.MarkSpam)
+		admin.DELETE("/comments/:id", commentHandler.ForceDelete)
+
+		admin.GET("/roles", roleHandler.List)
+		admin.POST("/users/:id/roles", roleHandler.Grant)
+		admin.DELETE("/users/:id/roles/:role_id", roleHandler.Revoke)
 	}
 
 	return r
@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"go-article/internal/service"
+	"log"
+)
+
+// PublishScheduledArticles membuat job yang menerbitkan artikel terjadwal per batch
+// sampai tidak ada lagi artikel yang jatuh tempo
+func PublishScheduledArticles(workflowService service.ArticleWorkflowService, batchSize int) Job {
+	return func(ctx context.Context) error {
+		for ctx.Err() == nil {
+			published, err := workflowService.PublishDue(batchSize)
+			if err != nil {
+				return err
+			}
+			if published > 0 {
+				log.Printf("[Scheduler] published %d scheduled article(s)", published)
+			}
+			if published < batchSize {
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// PurgeRevokedTokens membuat job yang menghapus jti kedaluwarsa dari denylist access token
+func PurgeRevokedTokens(sessionService service.SessionService) Job {
+	return func(ctx context.Context) error {
+		purged, err := sessionService.PurgeExpired()
+		if err != nil {
+			return err
+		}
+		if purged > 0 {
+			log.Printf("[Scheduler] purged %d expired revoked token(s)", purged)
+		}
+		return nil
+	}
+}
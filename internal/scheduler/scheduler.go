@@ -0,0 +1,100 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job adalah pekerjaan yang dijalankan berkala, ctx dibatalkan saat scheduler dihentikan
+type Job func(ctx context.Context) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      Job
+}
+
+// Scheduler menjalankan job berkala di dalam proses API. Setiap job berjalan di goroutine
+// sendiri dan tidak pernah tumpang tindih dengan dirinya sendiri; koordinasi antar replika
+// menjadi tanggung jawab job (misalnya lewat row-level locking di database).
+type Scheduler struct {
+	jobs   []job
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// New membuat Scheduler kosong
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every mendaftarkan job yang dijalankan setiap interval, harus dipanggil sebelum Start
+func (s *Scheduler) Every(name string, interval time.Duration, run Job) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// Start menjalankan semua job sampai ctx dibatalkan atau Stop dipanggil
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}()
+	}
+}
+
+// Stop menghentikan scheduler dan menunggu job yang sedang berjalan selesai atau ctx habis
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop menjalankan job sekali saat start lalu setiap interval
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	log.Printf("[Scheduler] job %s started, every %s", j.name, j.interval)
+	for {
+		s.runOnce(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			log.Printf("[Scheduler] job %s stopped", j.name)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce menjalankan job satu kali, panic di dalam job tidak menghentikan scheduler
+func (s *Scheduler) runOnce(ctx context.Context, j job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Scheduler] job %s panicked: %v", j.name, r)
+		}
+	}()
+
+	if err := j.run(ctx); err != nil {
+		log.Printf("[Scheduler] job %s failed: %v", j.name, err)
+	}
+}
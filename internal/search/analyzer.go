@@ -0,0 +1,117 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	LanguageIndonesian = "id"
+	LanguageEnglish    = "en"
+)
+
+// Token adalah satu kata dalam teks beserta posisi byte-nya di teks asli
+type Token struct {
+	// Term adalah bentuk kata yang sudah dinormalisasi dan di-stem
+	Term  string
+	Start int
+	End   int
+}
+
+// Analyzer mengubah teks menjadi term untuk diindeks: huruf kecil, tanpa diakritik,
+// tanpa stopword, lalu di-stem sesuai bahasa
+type Analyzer struct {
+	language  string
+	stopwords map[string]bool
+	stem      func(string) string
+}
+
+// NewAnalyzer membuat analyzer untuk bahasa "id" atau "en", bahasa lain memakai "id"
+func NewAnalyzer(language string) *Analyzer {
+	if language == LanguageEnglish {
+		return &Analyzer{language: LanguageEnglish, stopwords: englishStopwords, stem: stemEnglish}
+	}
+	return &Analyzer{language: LanguageIndonesian, stopwords: indonesianStopwords, stem: stemIndonesian}
+}
+
+// Language mengembalikan bahasa analyzer
+func (a *Analyzer) Language() string {
+	return a.language
+}
+
+// Terms mengembalikan term dari teks sesuai urutan kemunculannya
+func (a *Analyzer) Terms(text string) []string {
+	tokens := a.Tokens(text)
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		terms = append(terms, token.Term)
+	}
+	return terms
+}
+
+// Tokens memecah teks menjadi token, stopword dan token satu huruf dilewati
+func (a *Analyzer) Tokens(text string) []Token {
+	var tokens []Token
+
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := normalizeWord(text[start:end])
+		if utf8.RuneCountInString(word) > 1 && !a.stopwords[word] {
+			tokens = append(tokens, Token{Term: a.stem(word), Start: start, End: end})
+		}
+		start = -1
+	}
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+
+	return tokens
+}
+
+// normalizeWord membuat huruf kecil dan membuang tanda diakritik ("Kafé" menjadi "kafe")
+func normalizeWord(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(word)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var englishStopwords = toSet(
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "from", "has", "have", "he", "her", "his",
+	"how", "i", "if", "in", "into", "is", "it", "its", "me", "my", "no", "not", "of", "on", "or", "our", "she",
+	"so", "than", "that", "the", "their", "them", "then", "there", "these", "they", "this", "to", "was", "we",
+	"were", "what", "when", "where", "which", "who", "why", "will", "with", "you", "your",
+)
+
+var indonesianStopwords = toSet(
+	"ada", "adalah", "agar", "akan", "aku", "anda", "atau", "bagi", "bahwa", "banyak", "belum", "bisa", "dalam",
+	"dan", "dari", "dengan", "di", "dia", "harus", "hingga", "ia", "ini", "itu", "jika", "juga", "kami", "kamu",
+	"karena", "ke", "kita", "lagi", "lain", "maka", "mereka", "namun", "oleh", "pada", "para", "saja", "sangat",
+	"saya", "sebagai", "sedang", "sehingga", "sejak", "serta", "seperti", "sudah", "tapi", "telah", "tentang",
+	"tetapi", "untuk", "yaitu", "yang",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,84 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStemIndonesian(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"pembangunan", "bangun"},
+		{"menulis", "tulis"},
+		{"memukul", "pukul"},
+		{"menyapu", "sapu"},
+		{"mengambil", "ambil"},
+		{"dibacakan", "baca"},
+		{"bukunya", "buku"},
+		{"bekerja", "kerja"},
+		{"belajar", "ajar"},
+		{"pelajaran", "ajar"},
+		{"kebersihan", "bersih"},
+		{"rumah", "rumah"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := stemIndonesian(tt.word); got != tt.want {
+				t.Errorf("stemIndonesian(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStemEnglish(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"running", "run"},
+		{"caresses", "caress"},
+		{"ponies", "poni"},
+		{"agreed", "agre"},
+		{"hopping", "hop"},
+		{"connection", "connect"},
+		{"connected", "connect"},
+		{"relational", "relat"},
+		{"cat", "cat"},
+		{"café", "café"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := stemEnglish(tt.word); got != tt.want {
+				t.Errorf("stemEnglish(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzerTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		text     string
+		want     []string
+	}{
+		{"indonesian stopwords and stemming", LanguageIndonesian, "Pembangunan jalan di Jakarta", []string{"bangun", "jalan", "jakarta"}},
+		{"english stopwords and stemming", LanguageEnglish, "The Running of the Connections", []string{"run", "connect"}},
+		{"diacritics removed", LanguageIndonesian, "Kafé", []string{"kafe"}},
+		{"single letters skipped", LanguageEnglish, "a b go", []string{"go"}},
+		{"unknown language falls back to indonesian", "fr", "menulis", []string{"tulis"}},
+		{"empty text", LanguageEnglish, "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewAnalyzer(tt.language).Terms(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Terms(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
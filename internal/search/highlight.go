@@ -0,0 +1,138 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SnippetLength adalah panjang maksimal snippet dalam byte, tidak termasuk tag <mark>
+const SnippetLength = 200
+
+const ellipsis = "…"
+
+// QueryTerms mengubah teks pencarian menjadi himpunan term untuk scoring dan highlight
+func (a *Analyzer) QueryTerms(text string) map[string]bool {
+	terms := make(map[string]bool)
+	for _, term := range a.Terms(text) {
+		terms[term] = true
+	}
+	return terms
+}
+
+// Highlight mengembalikan seluruh text dengan kata yang cocok dibungkus <mark>.
+// Teks di-escape sehingga aman dirender sebagai HTML.
+func (a *Analyzer) Highlight(text string, terms map[string]bool) string {
+	return render(text, a.Tokens(text), terms, 0, len(text))
+}
+
+// Snippet mengambil potongan text sepanjang maxLength yang paling banyak memuat kata yang cocok.
+// Jika tidak ada yang cocok, bagian awal text dikembalikan dengan matched bernilai false.
+func (a *Analyzer) Snippet(text string, terms map[string]bool, maxLength int) (snippet string, matched bool) {
+	tokens := a.Tokens(text)
+
+	var matches []Token
+	for _, token := range tokens {
+		if terms[token.Term] {
+			matches = append(matches, token)
+		}
+	}
+
+	start := 0
+	if len(matches) > 0 {
+		// Jendela dimulai dari kecocokan yang diikuti kecocokan lain terbanyak dalam jarak maxLength
+		best, bestCount, bestSpan := 0, 0, 0
+		for i := range matches {
+			j := i
+			for j < len(matches) && matches[j].End-matches[i].Start <= maxLength {
+				j++
+			}
+			if j-i > bestCount {
+				best, bestCount, bestSpan = i, j-i, matches[j-1].End-matches[i].Start
+			}
+		}
+
+		// Sisa ruang dibagi agar kecocokan berada di tengah snippet
+		start = matches[best].Start - (maxLength-bestSpan)/2
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	start, end := wordBounds(text, start, start+maxLength)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(ellipsis)
+	}
+	b.WriteString(strings.TrimSpace(render(text, tokens, terms, start, end)))
+	if end < len(text) {
+		b.WriteString(ellipsis)
+	}
+	return b.String(), len(matches) > 0
+}
+
+// wordBounds menggeser start ke awal kata berikutnya dan end ke akhir kata sebelumnya agar snippet tidak memotong kata
+func wordBounds(text string, start int, end int) (int, int) {
+	if end > len(text) {
+		// Jendela melewati akhir teks, sisa ruangnya dipakai untuk konteks sebelum kecocokan
+		start -= end - len(text)
+		end = len(text)
+	}
+	if start <= 0 {
+		start = 0
+	} else if i := strings.IndexFunc(text[start:], unicode.IsSpace); i >= 0 && start+i < end {
+		start += i
+	}
+	for start < len(text) && !utf8.RuneStart(text[start]) {
+		start++
+	}
+
+	if end < len(text) {
+		if i := strings.LastIndexFunc(text[start:end], unicode.IsSpace); i > 0 {
+			end = start + i
+		}
+		for end > start && !utf8.RuneStart(text[end]) {
+			end--
+		}
+	}
+	return start, end
+}
+
+// render menulis text[start:end] dengan token yang cocok dibungkus <mark>
+func render(text string, tokens []Token, terms map[string]bool, start int, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, token := range tokens {
+		if token.Start < start || token.End > end || !terms[token.Term] {
+			continue
+		}
+		writeText(&b, text[pos:token.Start])
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[token.Start:token.End]))
+		b.WriteString("</mark>")
+		pos = token.End
+	}
+	writeText(&b, text[pos:end])
+	return b.String()
+}
+
+// writeText menulis teks yang sudah di-escape dengan whitespace beruntun diringkas menjadi satu spasi
+func writeText(b *strings.Builder, text string) {
+	space := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteString(html.EscapeString(string(r)))
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+}
@@ -0,0 +1,60 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	analyzer := NewAnalyzer(LanguageEnglish)
+
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{"stemmed match", "Running fast", "run", "<mark>Running</mark> fast"},
+		{"no match", "Running fast", "slow", "Running fast"},
+		{"html escaped around match", "<b>Go</b> & Rust", "rust", "&lt;b&gt;Go&lt;/b&gt; &amp; <mark>Rust</mark>"},
+		{"script tag escaped", `<script>alert("go")</script>`, "alert", `&lt;script&gt;<mark>alert</mark>(&#34;go&#34;)&lt;/script&gt;`},
+		{"whitespace collapsed", "go  \n\t rust", "rust", "go <mark>rust</mark>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := analyzer.Highlight(tt.text, analyzer.QueryTerms(tt.query))
+			if got != tt.want {
+				t.Errorf("Highlight(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	analyzer := NewAnalyzer(LanguageEnglish)
+	filler := strings.Repeat("lorem ipsum ", 30)
+
+	tests := []struct {
+		name        string
+		text        string
+		query       string
+		maxLength   int
+		want        string
+		wantMatched bool
+	}{
+		{"short text", "Go <is> fun", "fun", 50, "Go &lt;is&gt; <mark>fun</mark>", true},
+		{"no match returns start", "alpha beta gamma delta", "omega", 11, "alpha beta…", false},
+		{"match in the middle", filler + "golang " + filler, "golang", 30, "…ipsum <mark>golang</mark> lorem…", true},
+		{"match at the end", filler + "<golang>", "golang", 20, "…ipsum &lt;<mark>golang</mark>&gt;", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, matched := analyzer.Snippet(tt.text, analyzer.QueryTerms(tt.query), tt.maxLength)
+			if got != tt.want || matched != tt.wantMatched {
+				t.Errorf("Snippet() = (%q, %v), want (%q, %v)", got, matched, tt.want, tt.wantMatched)
+			}
+		})
+	}
+}
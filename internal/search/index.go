@@ -0,0 +1,108 @@
+package search
+
+import (
+	"go-article/internal/domain/entity"
+	"os"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Bobot setiap field saat menghitung relevansi, kecocokan di judul paling berpengaruh
+const (
+	titleWeight   = 3.0
+	tagsWeight    = 2.0
+	excerptWeight = 1.5
+	bodyWeight    = 1.0
+)
+
+// Document adalah isi artikel yang diindeks untuk pencarian
+type Document struct {
+	ArticleID uint64
+	Title     string
+	Excerpt   string
+	Body      string
+	Tags      []string
+}
+
+// Query berisi teks pencarian dan posisi halaman hasil
+type Query struct {
+	Text   string
+	Offset int
+	Limit  int
+}
+
+// Hit adalah satu artikel yang cocok beserta skor relevansi dan highlight-nya
+type Hit struct {
+	ArticleID uint64
+	Score     float64
+	// Title adalah judul artikel dengan kata yang cocok dibungkus <mark>
+	Title string
+	// Snippet adalah potongan excerpt atau body yang paling relevan dengan kata yang cocok dibungkus <mark>
+	Snippet string
+}
+
+// Result berisi hit pada halaman yang diminta dan total seluruh artikel yang cocok
+type Result struct {
+	Hits  []Hit
+	Total int64
+}
+
+// SearchIndex adalah interface untuk indeks pencarian full-text artikel.
+// Indeks hanya boleh berisi artikel yang sudah terbit.
+type SearchIndex interface {
+	// Index menambahkan atau mengganti dokumen artikel di indeks
+	Index(document Document) error
+	// Remove menghapus artikel dari indeks, tidak error jika artikel belum diindeks
+	Remove(articleID uint64) error
+	// Search mencari artikel yang cocok dengan query, diurutkan dari yang paling relevan
+	Search(query Query) (Result, error)
+	// NeedsRebuild melaporkan apakah indeks perlu diisi ulang dari database saat aplikasi start
+	NeedsRebuild() bool
+}
+
+// NewFromEnv memilih implementasi SearchIndex berdasarkan env SEARCH_DRIVER.
+// "memory" memakai MemoryIndex, selain itu memakai MySQLIndex. SEARCH_LANGUAGE ("id" atau "en") menentukan stemming.
+func NewFromEnv(db *gorm.DB) SearchIndex {
+	analyzer := NewAnalyzer(strings.ToLower(os.Getenv("SEARCH_LANGUAGE")))
+
+	switch strings.ToLower(os.Getenv("SEARCH_DRIVER")) {
+	case "memory":
+		return NewMemoryIndex(analyzer)
+	default:
+		return NewMySQLIndex(db, analyzer)
+	}
+}
+
+// DocumentFromArticle membuat Document dari artikel
+func DocumentFromArticle(article entity.ArticleEntity) Document {
+	document := Document{
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Body:      article.Body,
+		Tags:      make([]string, 0, len(article.Tags)),
+	}
+	if article.Excerpt != nil {
+		document.Excerpt = *article.Excerpt
+	}
+	for _, tag := range article.Tags {
+		document.Tags = append(document.Tags, tag.Name)
+	}
+	return document
+}
+
+// highlight membuat judul dan snippet ber-highlight untuk satu hit.
+// Snippet diambil dari excerpt jika mengandung kata yang dicari, selain itu dari body.
+func highlight(analyzer *Analyzer, hit *Hit, terms map[string]bool, title string, excerpt string, body string) {
+	hit.Title = analyzer.Highlight(title, terms)
+
+	if snippet, ok := analyzer.Snippet(excerpt, terms, SnippetLength); ok {
+		hit.Snippet = snippet
+		return
+	}
+	if snippet, ok := analyzer.Snippet(body, terms, SnippetLength); ok || excerpt == "" {
+		hit.Snippet = snippet
+		return
+	}
+	hit.Snippet, _ = analyzer.Snippet(excerpt, terms, SnippetLength)
+}
@@ -0,0 +1,166 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Parameter BM25: k1 mengatur saturasi frekuensi term, b mengatur normalisasi panjang dokumen
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// memoryDocument adalah dokumen yang sudah dianalisis beserta frekuensi term berbobotnya
+type memoryDocument struct {
+	document Document
+	// frequencies berisi frekuensi setiap term yang sudah dikalikan bobot field tempat term muncul
+	frequencies map[string]float64
+	// length adalah panjang dokumen berbobot, dipakai untuk normalisasi panjang BM25
+	length float64
+}
+
+// MemoryIndex adalah inverted index di memori dengan scoring BM25 berbobot per field.
+// Cocok untuk development dan testing tanpa database; isinya hilang saat aplikasi restart
+// sehingga perlu diisi ulang dari database saat start.
+type MemoryIndex struct {
+	mu          sync.RWMutex
+	analyzer    *Analyzer
+	documents   map[uint64]*memoryDocument
+	postings    map[string]map[uint64]struct{}
+	totalLength float64
+}
+
+// NewMemoryIndex membuat MemoryIndex kosong
+func NewMemoryIndex(analyzer *Analyzer) *MemoryIndex {
+	return &MemoryIndex{
+		analyzer:  analyzer,
+		documents: make(map[uint64]*memoryDocument),
+		postings:  make(map[string]map[uint64]struct{}),
+	}
+}
+
+// Index implements SearchIndex.
+func (m *MemoryIndex) Index(document Document) error {
+	doc := &memoryDocument{document: document, frequencies: make(map[string]float64)}
+	fields := []struct {
+		text   string
+		weight float64
+	}{
+		{document.Title, titleWeight},
+		{strings.Join(document.Tags, ", "), tagsWeight},
+		{document.Excerpt, excerptWeight},
+		{document.Body, bodyWeight},
+	}
+	for _, field := range fields {
+		for _, term := range m.analyzer.Terms(field.text) {
+			doc.frequencies[term] += field.weight
+			doc.length += field.weight
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(document.ArticleID)
+	m.documents[document.ArticleID] = doc
+	m.totalLength += doc.length
+	for term := range doc.frequencies {
+		if m.postings[term] == nil {
+			m.postings[term] = make(map[uint64]struct{})
+		}
+		m.postings[term][document.ArticleID] = struct{}{}
+	}
+	return nil
+}
+
+// Remove implements SearchIndex.
+func (m *MemoryIndex) Remove(articleID uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(articleID)
+	return nil
+}
+
+// remove menghapus dokumen dari indeks, pemanggil harus memegang write lock
+func (m *MemoryIndex) remove(articleID uint64) {
+	doc, ok := m.documents[articleID]
+	if !ok {
+		return
+	}
+
+	for term := range doc.frequencies {
+		delete(m.postings[term], articleID)
+		if len(m.postings[term]) == 0 {
+			delete(m.postings, term)
+		}
+	}
+	m.totalLength -= doc.length
+	delete(m.documents, articleID)
+}
+
+// Search implements SearchIndex.
+// Dokumen cocok jika memuat minimal satu term query, skor adalah jumlah skor BM25 setiap term.
+func (m *MemoryIndex) Search(query Query) (Result, error) {
+	terms := m.analyzer.QueryTerms(query.Text)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(terms) == 0 || len(m.documents) == 0 {
+		return Result{Hits: []Hit{}}, nil
+	}
+
+	total := float64(len(m.documents))
+	averageLength := m.totalLength / total
+
+	scores := make(map[uint64]float64)
+	for term := range terms {
+		postings := m.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+
+		frequency := float64(len(postings))
+		idf := math.Log(1 + (total-frequency+0.5)/(frequency+0.5))
+		for articleID := range postings {
+			doc := m.documents[articleID]
+			tf := doc.frequencies[term]
+			norm := bm25K1 * (1 - bm25B + bm25B*doc.length/averageLength)
+			scores[articleID] += idf * tf * (bm25K1 + 1) / (tf + norm)
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for articleID, score := range scores {
+		hits = append(hits, Hit{ArticleID: articleID, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ArticleID > hits[j].ArticleID
+	})
+
+	result := Result{Total: int64(len(hits))}
+	start := min(max(query.Offset, 0), len(hits))
+	end := len(hits)
+	if query.Limit > 0 {
+		end = min(start+query.Limit, len(hits))
+	}
+
+	result.Hits = hits[start:end]
+	for i := range result.Hits {
+		document := m.documents[result.Hits[i].ArticleID].document
+		highlight(m.analyzer, &result.Hits[i], terms, document.Title, document.Excerpt, document.Body)
+	}
+	return result, nil
+}
+
+// NeedsRebuild implements SearchIndex.
+func (m *MemoryIndex) NeedsRebuild() bool {
+	return true
+}
@@ -0,0 +1,129 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func hitIDs(hits []Hit) []uint64 {
+	ids := make([]uint64, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ArticleID)
+	}
+	return ids
+}
+
+func TestMemoryIndexRanking(t *testing.T) {
+	// Setiap dokumen memuat "golang" tepat sekali di field yang berbeda dengan panjang dokumen yang sama,
+	// sehingga urutan hasil hanya ditentukan oleh bobot field
+	documents := []Document{
+		{ArticleID: 1, Title: "catatan harian", Excerpt: "ringkasan pendek", Body: "golang isi artikel", Tags: []string{"umum"}},
+		{ArticleID: 2, Title: "catatan harian", Excerpt: "golang pendek", Body: "ringkasan isi artikel", Tags: []string{"umum"}},
+		{ArticleID: 3, Title: "catatan harian", Excerpt: "ringkasan pendek", Body: "umum isi artikel", Tags: []string{"golang"}},
+		{ArticleID: 4, Title: "golang harian", Excerpt: "ringkasan pendek", Body: "catatan isi artikel", Tags: []string{"umum"}},
+		{ArticleID: 5, Title: "catatan harian", Excerpt: "ringkasan pendek", Body: "umum isi artikel", Tags: []string{"rust"}},
+	}
+
+	tests := []struct {
+		name      string
+		query     Query
+		want      []uint64
+		wantTotal int64
+	}{
+		{"title over tags over excerpt over body", Query{Text: "golang"}, []uint64{4, 3, 2, 1}, 4},
+		{"query is stemmed", Query{Text: "golangnya"}, []uint64{4, 3, 2, 1}, 4},
+		{"rarer term scores higher", Query{Text: "golang rust"}, []uint64{5, 4, 3, 2, 1}, 5},
+		{"offset and limit", Query{Text: "golang", Offset: 1, Limit: 2}, []uint64{3, 2}, 4},
+		{"offset past the end", Query{Text: "golang", Offset: 10}, []uint64{}, 4},
+		{"no match", Query{Text: "python"}, []uint64{}, 0},
+		{"only stopwords", Query{Text: "yang dan di"}, []uint64{}, 0},
+	}
+
+	index := NewMemoryIndex(NewAnalyzer(LanguageIndonesian))
+	for _, document := range documents {
+		if err := index.Index(document); err != nil {
+			t.Fatalf("Index(%d): %v", document.ArticleID, err)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := index.Search(tt.query)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if got := hitIDs(result.Hits); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+			if result.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", result.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestMemoryIndexTermFrequencySaturates(t *testing.T) {
+	index := NewMemoryIndex(NewAnalyzer(LanguageEnglish))
+	_ = index.Index(Document{ArticleID: 1, Title: "golang", Body: "golang golang golang golang golang golang golang golang"})
+	_ = index.Index(Document{ArticleID: 2, Title: "golang", Body: "golang"})
+	_ = index.Index(Document{ArticleID: 3, Title: "rust", Body: "rust"})
+
+	result, _ := index.Search(Query{Text: "golang"})
+	if len(result.Hits) != 2 {
+		t.Fatalf("hits = %v, want 2 hits", hitIDs(result.Hits))
+	}
+	repeated, single := result.Hits[0], result.Hits[1]
+	if repeated.ArticleID != 1 || single.ArticleID != 2 {
+		t.Fatalf("hits = %v, want [1 2]", hitIDs(result.Hits))
+	}
+	// Delapan kali lebih banyak kemunculan tidak boleh menghasilkan skor delapan kali lipat
+	if repeated.Score >= 2*single.Score {
+		t.Errorf("score %f for repeated term is not saturated against %f", repeated.Score, single.Score)
+	}
+}
+
+func TestMemoryIndexReindexAndRemove(t *testing.T) {
+	index := NewMemoryIndex(NewAnalyzer(LanguageEnglish))
+	_ = index.Index(Document{ArticleID: 1, Title: "golang basics"})
+	_ = index.Index(Document{ArticleID: 1, Title: "rust basics"})
+
+	if result, _ := index.Search(Query{Text: "golang"}); result.Total != 0 {
+		t.Errorf("old title still indexed after reindex: %v", hitIDs(result.Hits))
+	}
+	if result, _ := index.Search(Query{Text: "rust"}); !reflect.DeepEqual(hitIDs(result.Hits), []uint64{1}) {
+		t.Errorf("hits = %v, want [1]", hitIDs(result.Hits))
+	}
+
+	_ = index.Remove(1)
+	_ = index.Remove(2)
+	if result, _ := index.Search(Query{Text: "basics"}); result.Total != 0 {
+		t.Errorf("removed document still found: %v", hitIDs(result.Hits))
+	}
+	if index.totalLength != 0 || len(index.postings) != 0 {
+		t.Errorf("index not empty after remove: length %f, %d postings", index.totalLength, len(index.postings))
+	}
+}
+
+func TestMemoryIndexHighlightsHits(t *testing.T) {
+	index := NewMemoryIndex(NewAnalyzer(LanguageEnglish))
+	_ = index.Index(Document{
+		ArticleID: 1,
+		Title:     "Running <Go> & friends",
+		Excerpt:   "A short intro",
+		Body:      "Tips for <b>running</b> services",
+	})
+
+	result, _ := index.Search(Query{Text: "run"})
+	if len(result.Hits) != 1 {
+		t.Fatalf("hits = %v, want [1]", hitIDs(result.Hits))
+	}
+
+	hit := result.Hits[0]
+	if want := "<mark>Running</mark> &lt;Go&gt; &amp; friends"; hit.Title != want {
+		t.Errorf("title = %q, want %q", hit.Title, want)
+	}
+	// Excerpt tidak memuat kata yang dicari sehingga snippet diambil dari body
+	if want := "Tips for &lt;b&gt;<mark>running</mark>&lt;/b&gt; services"; hit.Snippet != want {
+		t.Errorf("snippet = %q, want %q", hit.Snippet, want)
+	}
+}
@@ -0,0 +1,112 @@
+package search
+
+import (
+	"go-article/internal/domain/model"
+	"log"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// MySQLIndex memakai indeks FULLTEXT MySQL pada tabel articles dan tags.
+// MySQL memperbarui indeks FULLTEXT sendiri saat data berubah, sehingga Index dan Remove tidak melakukan apa-apa.
+type MySQLIndex struct {
+	db       *gorm.DB
+	analyzer *Analyzer
+}
+
+// NewMySQLIndex membuat MySQLIndex, analyzer hanya dipakai untuk highlight hasil pencarian
+func NewMySQLIndex(db *gorm.DB, analyzer *Analyzer) *MySQLIndex {
+	return &MySQLIndex{db: db, analyzer: analyzer}
+}
+
+// mysqlMatches membatasi artikel ke yang sudah terbit dan cocok dengan query di judul, excerpt, body atau nama tag
+const mysqlMatches = `articles.deleted_at IS NULL AND articles.status = @status AND (
+	MATCH (articles.title, articles.excerpt, articles.body) AGAINST (@query IN NATURAL LANGUAGE MODE)
+	OR EXISTS (
+		SELECT 1 FROM article_tag JOIN tags ON tags.id = article_tag.tag_id
+		WHERE article_tag.article_id = articles.id AND MATCH (tags.name) AGAINST (@query IN NATURAL LANGUAGE MODE)
+	)
+)`
+
+// mysqlScore menjumlahkan relevansi setiap field sesuai bobotnya
+var mysqlScore = "MATCH (articles.title) AGAINST (@query IN NATURAL LANGUAGE MODE) * " + formatWeight(titleWeight) + `
+	+ MATCH (articles.excerpt) AGAINST (@query IN NATURAL LANGUAGE MODE) * ` + formatWeight(excerptWeight) + `
+	+ MATCH (articles.title, articles.excerpt, articles.body) AGAINST (@query IN NATURAL LANGUAGE MODE) * ` + formatWeight(bodyWeight) + `
+	+ COALESCE((
+		SELECT MAX(MATCH (tags.name) AGAINST (@query IN NATURAL LANGUAGE MODE))
+		FROM article_tag JOIN tags ON tags.id = article_tag.tag_id
+		WHERE article_tag.article_id = articles.id
+	), 0) * ` + formatWeight(tagsWeight)
+
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'f', -1, 64)
+}
+
+type mysqlHit struct {
+	ID      uint64
+	Title   string
+	Excerpt *string
+	Body    string
+	Score   float64
+}
+
+// Index implements SearchIndex.
+func (m *MySQLIndex) Index(document Document) error {
+	return nil
+}
+
+// Remove implements SearchIndex.
+func (m *MySQLIndex) Remove(articleID uint64) error {
+	return nil
+}
+
+// Search implements SearchIndex.
+func (m *MySQLIndex) Search(query Query) (Result, error) {
+	terms := m.analyzer.QueryTerms(query.Text)
+	if len(terms) == 0 {
+		return Result{Hits: []Hit{}}, nil
+	}
+
+	args := map[string]interface{}{
+		"status": model.ArticleStatusPublished,
+		"query":  query.Text,
+		"limit":  query.Limit,
+		"offset": query.Offset,
+	}
+
+	var total int64
+	if err := m.db.Raw("SELECT COUNT(*) FROM articles WHERE "+mysqlMatches, args).Scan(&total).Error; err != nil {
+		log.Println("[MySQLIndex] Search - counting:", err)
+		return Result{}, err
+	}
+
+	var rows []mysqlHit
+	err := m.db.Raw(
+		"SELECT articles.id, articles.title, articles.excerpt, articles.body, ("+mysqlScore+") AS score FROM articles WHERE "+mysqlMatches+
+			" ORDER BY score DESC, articles.id DESC LIMIT @limit OFFSET @offset",
+		args,
+	).Scan(&rows).Error
+	if err != nil {
+		log.Println("[MySQLIndex] Search:", err)
+		return Result{}, err
+	}
+
+	result := Result{Hits: make([]Hit, 0, len(rows)), Total: total}
+	for _, row := range rows {
+		hit := Hit{ArticleID: row.ID, Score: row.Score}
+
+		excerpt := ""
+		if row.Excerpt != nil {
+			excerpt = *row.Excerpt
+		}
+		highlight(m.analyzer, &hit, terms, row.Title, excerpt, row.Body)
+		result.Hits = append(result.Hits, hit)
+	}
+	return result, nil
+}
+
+// NeedsRebuild implements SearchIndex.
+func (m *MySQLIndex) NeedsRebuild() bool {
+	return false
+}
@@ -0,0 +1,213 @@
+package search
+
+import "strings"
+
+// stemEnglish mengembalikan kata dasar bahasa Inggris dengan algoritma Porter (1980).
+// Kata yang mengandung huruf non-ASCII atau lebih pendek dari tiga huruf dikembalikan apa adanya.
+func stemEnglish(word string) string {
+	if len(word) <= 2 || !isASCIILetters(word) {
+		return word
+	}
+
+	p := &porter{b: []byte(word)}
+	p.step1a()
+	p.step1b()
+	p.step1c()
+	p.replaceSuffix(porterStep2, 0)
+	p.replaceSuffix(porterStep3, 0)
+	p.step4()
+	p.step5()
+	return string(p.b)
+}
+
+func isASCIILetters(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+type porter struct {
+	b []byte
+}
+
+// cons melaporkan apakah huruf ke-i adalah konsonan, "y" setelah konsonan dianggap vokal
+func (p *porter) cons(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !p.cons(i-1)
+	}
+	return true
+}
+
+// measure menghitung jumlah urutan vokal-konsonan (m) pada b[:j]
+func (p *porter) measure(j int) int {
+	n, i := 0, 0
+	for i < j && p.cons(i) {
+		i++
+	}
+	for i < j {
+		for i < j && !p.cons(i) {
+			i++
+		}
+		if i >= j {
+			return n
+		}
+		n++
+		for i < j && p.cons(i) {
+			i++
+		}
+	}
+	return n
+}
+
+// hasVowel melaporkan apakah b[:j] mengandung vokal
+func (p *porter) hasVowel(j int) bool {
+	for i := 0; i < j; i++ {
+		if !p.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleCons melaporkan apakah b[:j] diakhiri dua konsonan yang sama
+func (p *porter) doubleCons(j int) bool {
+	return j >= 2 && p.b[j-1] == p.b[j-2] && p.cons(j-1)
+}
+
+// cvc melaporkan apakah b[:j] diakhiri konsonan-vokal-konsonan dengan konsonan terakhir bukan w, x atau y
+func (p *porter) cvc(j int) bool {
+	if j < 3 || !p.cons(j-1) || p.cons(j-2) || !p.cons(j-3) {
+		return false
+	}
+	c := p.b[j-1]
+	return c != 'w' && c != 'x' && c != 'y'
+}
+
+func (p *porter) ends(suffix string) bool {
+	return strings.HasSuffix(string(p.b), suffix)
+}
+
+// stem mengembalikan panjang kata tanpa suffix
+func (p *porter) stem(suffix string) int {
+	return len(p.b) - len(suffix)
+}
+
+func (p *porter) setSuffix(suffix string, replacement string) {
+	p.b = append(p.b[:p.stem(suffix)], replacement...)
+}
+
+func (p *porter) step1a() {
+	switch {
+	case p.ends("sses"), p.ends("ies"):
+		p.b = p.b[:len(p.b)-2]
+	case p.ends("ss"):
+	case p.ends("s"):
+		p.b = p.b[:len(p.b)-1]
+	}
+}
+
+func (p *porter) step1b() {
+	if p.ends("eed") {
+		if p.measure(p.stem("eed")) > 0 {
+			p.b = p.b[:len(p.b)-1]
+		}
+		return
+	}
+
+	removed := false
+	for _, suffix := range []string{"ed", "ing"} {
+		if p.ends(suffix) && p.hasVowel(p.stem(suffix)) {
+			p.b = p.b[:p.stem(suffix)]
+			removed = true
+			break
+		}
+	}
+	if !removed {
+		return
+	}
+
+	switch {
+	case p.ends("at"), p.ends("bl"), p.ends("iz"):
+		p.b = append(p.b, 'e')
+	case p.doubleCons(len(p.b)):
+		if c := p.b[len(p.b)-1]; c != 'l' && c != 's' && c != 'z' {
+			p.b = p.b[:len(p.b)-1]
+		}
+	case p.measure(len(p.b)) == 1 && p.cvc(len(p.b)):
+		p.b = append(p.b, 'e')
+	}
+}
+
+func (p *porter) step1c() {
+	if p.ends("y") && p.hasVowel(p.stem("y")) {
+		p.b[len(p.b)-1] = 'i'
+	}
+}
+
+// replaceSuffix mengganti suffix terpanjang yang cocok jika measure stem-nya lebih dari minMeasure.
+// Hanya suffix pertama yang cocok yang dipertimbangkan, sesuai aturan Porter.
+func (p *porter) replaceSuffix(rules [][2]string, minMeasure int) {
+	for _, rule := range rules {
+		if p.ends(rule[0]) {
+			if p.measure(p.stem(rule[0])) > minMeasure {
+				p.setSuffix(rule[0], rule[1])
+			}
+			return
+		}
+	}
+}
+
+func (p *porter) step4() {
+	for _, suffix := range porterStep4 {
+		if !p.ends(suffix) {
+			continue
+		}
+
+		j := p.stem(suffix)
+		if p.measure(j) <= 1 {
+			return
+		}
+		if suffix == "ion" && (j == 0 || (p.b[j-1] != 's' && p.b[j-1] != 't')) {
+			return
+		}
+		p.b = p.b[:j]
+		return
+	}
+}
+
+func (p *porter) step5() {
+	if p.ends("e") {
+		j := p.stem("e")
+		if m := p.measure(j); m > 1 || (m == 1 && !p.cvc(j)) {
+			p.b = p.b[:j]
+		}
+	}
+
+	if p.measure(len(p.b)) > 1 && p.doubleCons(len(p.b)) && p.ends("l") {
+		p.b = p.b[:len(p.b)-1]
+	}
+}
+
+// Aturan step 2 dan 3 diurutkan sehingga suffix yang lebih panjang dicek lebih dulu
+var porterStep2 = [][2]string{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"}, {"izer", "ize"},
+	{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"},
+	{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"},
+	{"fulness", "ful"}, {"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	{"logi", "log"},
+}
+
+var porterStep3 = [][2]string{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"}, {"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+var porterStep4 = []string{
+	"ement", "ance", "ence", "able", "ible", "ment", "ant", "ent", "ion", "ism", "ate", "iti", "ous", "ive",
+	"ize", "al", "er", "ic", "ou",
+}
@@ -0,0 +1,115 @@
+package search
+
+import "strings"
+
+// stemIndonesian mengembalikan kata dasar bahasa Indonesia dengan algoritma Tala yang berbasis aturan
+// (tanpa kamus): partikel, kata ganti milik, prefiks dan sufiks dibuang selama kata masih punya
+// lebih dari dua suku kata.
+func stemIndonesian(word string) string {
+	if syllables(word) <= 2 {
+		return word
+	}
+
+	word = trimSuffixes(word, "kah", "lah", "tah", "pun")
+	word = trimSuffixes(word, "nya", "ku", "mu")
+
+	if stemmed, ok := trimFirstOrderPrefix(word); ok {
+		word = stemmed
+		if stemmed, ok := trimDerivationSuffix(word); ok {
+			word, _ = trimSecondOrderPrefix(stemmed)
+		}
+		return word
+	}
+
+	word, _ = trimSecondOrderPrefix(word)
+	word, _ = trimDerivationSuffix(word)
+	return word
+}
+
+// syllables memperkirakan jumlah suku kata dari jumlah vokal, seperti pada algoritma Tala ("baik" dihitung dua)
+func syllables(word string) int {
+	n := 0
+	for i := 0; i < len(word); i++ {
+		if isVowel(word[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
+
+// trimSuffixes membuang suffix pertama yang cocok jika sisa kata masih punya minimal dua suku kata
+func trimSuffixes(word string, suffixes ...string) string {
+	for _, suffix := range suffixes {
+		if stem, ok := strings.CutSuffix(word, suffix); ok {
+			if syllables(stem) >= 2 {
+				return stem
+			}
+			return word
+		}
+	}
+	return word
+}
+
+func trimDerivationSuffix(word string) (string, bool) {
+	for _, suffix := range []string{"kan", "an", "i"} {
+		if stem, ok := strings.CutSuffix(word, suffix); ok && syllables(stem) >= 2 {
+			return stem, true
+		}
+	}
+	return word, false
+}
+
+// trimFirstOrderPrefix membuang prefiks me-, pe-, di-, ter- dan ke- beserta peluluhan
+// konsonan awal, misalnya "menulis" menjadi "tulis" dan "memukul" menjadi "pukul"
+func trimFirstOrderPrefix(word string) (string, bool) {
+	rules := []struct {
+		prefix string
+		// vowelReplacement dipakai jika huruf setelah prefiks adalah vokal
+		vowelReplacement string
+	}{
+		{"meng", ""}, {"meny", "s"}, {"mem", "p"}, {"men", "t"}, {"me", ""},
+		{"peng", ""}, {"peny", "s"}, {"pem", "p"}, {"pen", "t"},
+		{"di", ""}, {"ter", ""}, {"ke", ""},
+	}
+
+	for _, rule := range rules {
+		rest, ok := strings.CutPrefix(word, rule.prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		if isVowel(rest[0]) {
+			rest = rule.vowelReplacement + rest
+		}
+		if syllables(rest) < 2 {
+			return word, false
+		}
+		return rest, true
+	}
+	return word, false
+}
+
+// trimSecondOrderPrefix membuang prefiks ber-, per-, be- dan pe-, serta bel-/pel- pada kata "ajar"
+func trimSecondOrderPrefix(word string) (string, bool) {
+	for _, prefix := range []string{"belajar", "pelajar"} {
+		if rest, ok := strings.CutPrefix(word, prefix); ok {
+			return "ajar" + rest, true
+		}
+	}
+
+	for _, prefix := range []string{"ber", "per", "be", "pe"} {
+		rest, ok := strings.CutPrefix(word, prefix)
+		if !ok || rest == "" || syllables(rest) < 2 {
+			continue
+		}
+		// be- dan pe- hanya dibuang sebelum konsonan, seperti "bekerja" atau "pekerja"
+		if len(prefix) == 2 && isVowel(rest[0]) {
+			continue
+		}
+		return rest, true
+	}
+	return word, false
+}
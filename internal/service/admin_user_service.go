@@ -0,0 +1,261 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type AdminUserService interface {
+	ListUsers(query request.AdminUserListQuery) ([]entity.UserEntity, int64, error)
+	// ListUsersByCursor sama seperti ListUsers tetapi memakai pagination cursor sesuai urutan sort
+	ListUsersByCursor(query request.AdminUserListQuery) ([]entity.UserEntity, utils.CursorMeta, error)
+	GetUser(userID uint64) (*entity.UserEntity, error)
+	UpdateUser(userID uint64, request request.AdminUpdateUserRequest) (*entity.UserEntity, error)
+	DeleteUser(adminID uint64, userID uint64) error
+	RestoreUser(userID uint64) (*entity.UserEntity, error)
+	SuspendUser(adminID uint64, userID uint64) (*entity.UserEntity, error)
+	ActivateUser(userID uint64) (*entity.UserEntity, error)
+	// UnlockUser membuka kunci akun yang tertahan karena terlalu banyak gagal login
+	UnlockUser(userID uint64) (*entity.UserEntity, error)
+	// SignOutUser memaksa user keluar dari semua perangkat, access token yang sudah terbit langsung ditolak
+	SignOutUser(userID uint64) error
+	// ListLoginAttempts mengambil audit percobaan login terbaru sesuai filter
+	ListLoginAttempts(query request.LoginAttemptListQuery) ([]entity.LoginAttemptEntity, int64, error)
+}
+
+type adminUserService struct {
+	userRepository         repository.UserRepository
+	sessionService         SessionService
+	loginAttemptRepository repository.LoginAttemptRepository
+}
+
+func NewAdminUserService(userRepo repository.UserRepository, sessionService SessionService, loginAttemptRepo repository.LoginAttemptRepository) AdminUserService {
+	return &adminUserService{
+		userRepository:         userRepo,
+		sessionService:         sessionService,
+		loginAttemptRepository: loginAttemptRepo,
+	}
+}
+
+// ListUsers implements AdminUserService.
+func (a *adminUserService) ListUsers(query request.AdminUserListQuery) ([]entity.UserEntity, int64, error) {
+	return a.userRepository.FindAll(userListFilter(query))
+}
+
+// ListUsersByCursor implements AdminUserService.
+func (a *adminUserService) ListUsersByCursor(query request.AdminUserListQuery) ([]entity.UserEntity, utils.CursorMeta, error) {
+	filter := userListFilter(query)
+
+	// Key cursor bergantung pada kolom sort, sehingga cursor hanya berlaku untuk urutan yang sama
+	scope := "users:" + query.Sort
+	cursor, err := decodeCursor(query.Cursor, scope)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	var keyset *repository.Keyset
+	if cursor != nil {
+		if filter.SortBy == "name" || filter.SortBy == "email" {
+			keyset = &repository.Keyset{Key: cursor.Key, ID: cursor.ID, Backward: cursor.Backward}
+		} else if keyset, err = timeKeyset(cursor); err != nil {
+			return nil, utils.CursorMeta{}, err
+		}
+	}
+
+	users, hasMore, err := a.userRepository.FindAllByCursor(filter, keyset)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	positions := make([]utils.Cursor, 0, len(users))
+	for _, user := range users {
+		switch filter.SortBy {
+		case "name":
+			positions = append(positions, utils.Cursor{Scope: scope, Key: user.Name, ID: user.ID})
+		case "email":
+			positions = append(positions, utils.Cursor{Scope: scope, Key: user.Email, ID: user.ID})
+		default:
+			positions = append(positions, timeCursor(scope, user.CreatedAt, user.ID))
+		}
+	}
+
+	meta, err := utils.NewCursorMeta(query.Limit, cursor, positions, hasMore)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+	return users, meta, nil
+}
+
+// userListFilter membuat filter daftar user dari query panel admin
+func userListFilter(query request.AdminUserListQuery) repository.UserFilter {
+	return repository.UserFilter{
+		Search:   strings.TrimSpace(query.Search),
+		Role:     query.Role,
+		Verified: query.Verified,
+		Status:   query.Status,
+		Deleted:  query.Deleted,
+		SortBy:   strings.TrimPrefix(query.Sort, "-"),
+		SortDesc: strings.HasPrefix(query.Sort, "-"),
+		Page:     query.Page,
+		Limit:    query.Limit,
+	}
+}
+
+// GetUser implements AdminUserService.
+func (a *adminUserService) GetUser(userID uint64) (*entity.UserEntity, error) {
+	user, err := a.userRepository.FindByIDWithDeleted(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// UpdateUser implements AdminUserService.
+func (a *adminUserService) UpdateUser(userID uint64, request request.AdminUpdateUserRequest) (*entity.UserEntity, error) {
+	user, err := a.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	// User yang sudah dihapus harus di-restore dulu agar email-nya tidak bisa diambil alih lewat edit
+	if user.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+
+	name, email, avatar := user.Name, user.Email, user.Avatar
+	if request.Name != nil {
+		name = strings.TrimSpace(*request.Name)
+	}
+	if request.Email != nil {
+		email = *request.Email
+	}
+	if request.Avatar != nil {
+		avatar = request.Avatar
+		if *request.Avatar == "" {
+			avatar = nil
+		}
+	}
+
+	// Email baru belum pernah diverifikasi, user harus memverifikasinya lagi
+	emailChanged := !strings.EqualFold(email, user.Email)
+	if err := a.userRepository.UpdateByAdmin(userID, name, email, avatar, emailChanged); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrEmailAlreadyRegistered
+		}
+		return nil, err
+	}
+
+	return a.GetUser(userID)
+}
+
+// DeleteUser implements AdminUserService.
+func (a *adminUserService) DeleteUser(adminID uint64, userID uint64) error {
+	if adminID == userID {
+		return ErrCannotDeleteSelf
+	}
+
+	user, err := a.GetUser(userID)
+	if err != nil {
+		return err
+	}
+	if user.DeletedAt != nil {
+		return nil
+	}
+
+	if err := a.userRepository.SoftDelete(userID); err != nil {
+		return err
+	}
+
+	return a.sessionService.RevokeAll(userID)
+}
+
+// RestoreUser implements AdminUserService.
+func (a *adminUserService) RestoreUser(userID uint64) (*entity.UserEntity, error) {
+	if _, err := a.GetUser(userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.userRepository.Restore(userID); err != nil {
+		return nil, err
+	}
+
+	return a.GetUser(userID)
+}
+
+// SuspendUser implements AdminUserService.
+func (a *adminUserService) SuspendUser(adminID uint64, userID uint64) (*entity.UserEntity, error) {
+	if adminID == userID {
+		return nil, ErrCannotSuspendSelf
+	}
+
+	if _, err := a.GetUser(userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.userRepository.UpdateStatus(userID, model.UserStatusSuspended); err != nil {
+		return nil, err
+	}
+
+	// User yang di-suspend tidak boleh memperpanjang sesinya, dan sesinya tidak hidup lagi setelah diaktifkan
+	if err := a.sessionService.RevokeAll(userID); err != nil {
+		return nil, err
+	}
+
+	return a.GetUser(userID)
+}
+
+// ActivateUser implements AdminUserService.
+func (a *adminUserService) ActivateUser(userID uint64) (*entity.UserEntity, error) {
+	if _, err := a.GetUser(userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.userRepository.UpdateStatus(userID, model.UserStatusActive); err != nil {
+		return nil, err
+	}
+
+	return a.GetUser(userID)
+}
+
+// UnlockUser implements AdminUserService.
+func (a *adminUserService) UnlockUser(userID uint64) (*entity.UserEntity, error) {
+	if _, err := a.GetUser(userID); err != nil {
+		return nil, err
+	}
+
+	if err := a.userRepository.ResetLoginFailures(userID); err != nil {
+		return nil, err
+	}
+
+	return a.GetUser(userID)
+}
+
+// SignOutUser implements AdminUserService.
+func (a *adminUserService) SignOutUser(userID uint64) error {
+	if _, err := a.GetUser(userID); err != nil {
+		return err
+	}
+
+	return a.sessionService.RevokeAll(userID)
+}
+
+// ListLoginAttempts implements AdminUserService.
+func (a *adminUserService) ListLoginAttempts(query request.LoginAttemptListQuery) ([]entity.LoginAttemptEntity, int64, error) {
+	return a.loginAttemptRepository.FindAll(repository.LoginAttemptFilter{
+		UserID:    query.UserID,
+		Email:     strings.ToLower(strings.TrimSpace(query.Email)),
+		IPAddress: strings.TrimSpace(query.IP),
+		Result:    query.Result,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	})
+}
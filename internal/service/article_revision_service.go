@@ -0,0 +1,159 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-article/internal/domain/entity"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/internal/search"
+	"go-article/pkg/diff"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type ArticleRevisionService interface {
+	List(userID uint64, articleID uint64, query request.RevisionListQuery) ([]entity.ArticleRevisionEntity, int64, error)
+	Get(userID uint64, articleID uint64, number int) (*entity.ArticleRevisionEntity, error)
+	Diff(userID uint64, articleID uint64, query request.RevisionDiffQuery) (*entity.ArticleRevisionDiffEntity, error)
+	// Restore mengembalikan isi artikel ke revisi lama dengan membuat revisi baru, riwayat lama tidak diubah
+	Restore(userID uint64, articleID uint64, number int) (*entity.ArticleEntity, error)
+}
+
+type articleRevisionService struct {
+	articleRepository repository.ArticleRepository
+	searchIndex       search.SearchIndex
+}
+
+func NewArticleRevisionService(articleRepo repository.ArticleRepository, searchIndex search.SearchIndex) ArticleRevisionService {
+	return &articleRevisionService{articleRepository: articleRepo, searchIndex: searchIndex}
+}
+
+// List implements ArticleRevisionService.
+func (s *articleRevisionService) List(userID uint64, articleID uint64, query request.RevisionListQuery) ([]entity.ArticleRevisionEntity, int64, error) {
+	article, err := s.ownedArticle(userID, articleID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.articleRepository.FindRevisions(article.ID, query.Page, query.Limit)
+}
+
+// Get implements ArticleRevisionService.
+func (s *articleRevisionService) Get(userID uint64, articleID uint64, number int) (*entity.ArticleRevisionEntity, error) {
+	article, err := s.ownedArticle(userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findRevision(article.ID, number)
+}
+
+// Diff implements ArticleRevisionService.
+func (s *articleRevisionService) Diff(userID uint64, articleID uint64, query request.RevisionDiffQuery) (*entity.ArticleRevisionDiffEntity, error) {
+	article, err := s.ownedArticle(userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.findRevision(article.ID, query.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.findRevision(article.ID, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ArticleRevisionDiffEntity{
+		ArticleID:    article.ID,
+		From:         from.RevisionNumber,
+		To:           to.RevisionNumber,
+		TitleFrom:    from.Title,
+		TitleTo:      to.Title,
+		TitleChanged: from.Title != to.Title,
+		Diff: diff.Unified(
+			fmt.Sprintf("revision %d", from.RevisionNumber),
+			fmt.Sprintf("revision %d", to.RevisionNumber),
+			from.Body, to.Body, diff.DefaultContext,
+		),
+	}, nil
+}
+
+// Restore implements ArticleRevisionService.
+func (s *articleRevisionService) Restore(userID uint64, articleID uint64, number int) (*entity.ArticleEntity, error) {
+	article, err := s.ownedArticle(userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pemulihan adalah perubahan isi, jadi mengikuti aturan kunci yang sama dengan Update
+	if !isEditable(article) {
+		return nil, ErrArticleLocked
+	}
+
+	revision, err := s.findRevision(article.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.Title == revision.Title && article.Body == revision.Body && sameExcerpt(article.Excerpt, revision.Excerpt) {
+		return nil, ErrRevisionAlreadyCurrent
+	}
+
+	// Kategori dan tag tidak termasuk revisi sehingga tetap seperti sekarang,
+	// slug mengikuti judul yang dipulihkan sama seperti saat artikel diedit
+	current := *article
+	article.Title = revision.Title
+	article.Excerpt = revision.Excerpt
+	article.Body = revision.Body
+
+	restored, err := saveWithUniqueSlug(s.articleRepository, revision.Title, &current, func(slug string) (*entity.ArticleEntity, error) {
+		article.Slug = slug
+		return s.articleRepository.Update(*article, repository.RevisionInfo{
+			EditorID:       userID,
+			RestoredFromID: &revision.ID,
+		})
+	})
+	if err != nil {
+		log.Println("Error restoring article revision:", err)
+		return nil, err
+	}
+
+	syncSearchIndex(s.searchIndex, restored)
+	return restored, nil
+}
+
+// ownedArticle mengambil artikel dan memastikan user adalah author-nya
+func (s *articleRevisionService) ownedArticle(userID uint64, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.AuthorID != userID {
+		return nil, ErrArticleForbidden
+	}
+	return article, nil
+}
+
+// findRevision mengambil revisi dan menerjemahkan record not found menjadi ErrRevisionNotFound
+func (s *articleRevisionService) findRevision(articleID uint64, number int) (*entity.ArticleRevisionEntity, error) {
+	revision, err := s.articleRepository.FindRevision(articleID, number)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+// sameExcerpt membandingkan dua excerpt yang bisa bernilai nil
+func sameExcerpt(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/internal/search"
+	"go-article/pkg/utils"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// excerptLength adalah jumlah karakter maksimal excerpt yang dibuat otomatis dari body
+const excerptLength = 160
+
+// articleCursorScope mengikat cursor daftar artikel agar tidak bisa dipakai di daftar lain
+const articleCursorScope = "articles"
+
+type ArticleService interface {
+	Create(authorID uint64, request request.CreateArticleRequest) (*entity.ArticleEntity, error)
+	GetByID(userID uint64, articleID uint64) (*entity.ArticleEntity, error)
+	// GetBySlug mencari artikel berdasarkan slug, moved bernilai true jika slug yang dipakai adalah slug lama
+	GetBySlug(userID uint64, slug string) (article *entity.ArticleEntity, moved bool, err error)
+	List(userID uint64, query request.ArticleListQuery) ([]entity.ArticleEntity, int64, error)
+	// ListByCursor sama seperti List tetapi memakai pagination cursor (keyset published_at+id)
+	ListByCursor(userID uint64, query request.ArticleListQuery) ([]entity.ArticleEntity, utils.CursorMeta, error)
+	Update(userID uint64, articleID uint64, request request.UpdateArticleRequest) (*entity.ArticleEntity, error)
+	Delete(userID uint64, articleID uint64) error
+	ForceDelete(articleID uint64) error
+}
+
+type articleService struct {
+	articleRepository  repository.ArticleRepository
+	userRepository     repository.UserRepository
+	categoryRepository repository.CategoryRepository
+	tagRepository      repository.TagRepository
+	searchIndex        search.SearchIndex
+}
+
+func NewArticleService(articleRepo repository.ArticleRepository, userRepo repository.UserRepository, categoryRepo repository.CategoryRepository, tagRepo repository.TagRepository, searchIndex search.SearchIndex) ArticleService {
+	return &articleService{
+		articleRepository:  articleRepo,
+		userRepository:     userRepo,
+		categoryRepository: categoryRepo,
+		tagRepository:      tagRepo,
+		searchIndex:        searchIndex,
+	}
+}
+
+// Create implements ArticleService.
+func (a *articleService) Create(authorID uint64, request request.CreateArticleRequest) (*entity.ArticleEntity, error) {
+	// Artikel baru selalu draft, publikasi harus melalui review editor
+	if err := a.ensureCategoryExists(request.CategoryID); err != nil {
+		return nil, err
+	}
+
+	tags, err := a.resolveTags(request.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	article := entity.ArticleEntity{
+		AuthorID:   authorID,
+		CategoryID: request.CategoryID,
+		Title:      request.Title,
+		Excerpt:    buildExcerpt(request.Excerpt, request.Body),
+		Body:       request.Body,
+		Status:     model.ArticleStatusDraft,
+		Tags:       tags,
+	}
+
+	newArticle, err := saveWithUniqueSlug(a.articleRepository, request.Title, nil, func(slug string) (*entity.ArticleEntity, error) {
+		article.Slug = slug
+		return a.articleRepository.Create(article)
+	})
+	if err != nil {
+		log.Println("Error creating article:", err)
+		return nil, err
+	}
+
+	return newArticle, nil
+}
+
+// GetByID implements ArticleService.
+func (a *articleService) GetByID(userID uint64, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(a.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewArticle(article, userID) {
+		return nil, ErrArticleNotFound
+	}
+
+	return article, nil
+}
+
+// GetBySlug implements ArticleService.
+func (a *articleService) GetBySlug(userID uint64, slug string) (*entity.ArticleEntity, bool, error) {
+	article, err := a.articleRepository.FindBySlug(slug)
+	if err == nil {
+		if !canViewArticle(article, userID) {
+			return nil, false, ErrArticleNotFound
+		}
+		return article, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	// Slug tidak aktif, cari artikel yang dulu memakainya
+	articleID, err := a.articleRepository.FindIDBySlugRedirect(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrArticleNotFound
+		}
+		return nil, false, err
+	}
+
+	article, err = a.GetByID(userID, articleID)
+	if err != nil {
+		return nil, false, err
+	}
+	return article, true, nil
+}
+
+// List implements ArticleService.
+func (a *articleService) List(userID uint64, query request.ArticleListQuery) ([]entity.ArticleEntity, int64, error) {
+	return a.articleRepository.FindAll(articleListFilter(userID, query))
+}
+
+// ListByCursor implements ArticleService.
+func (a *articleService) ListByCursor(userID uint64, query request.ArticleListQuery) ([]entity.ArticleEntity, utils.CursorMeta, error) {
+	cursor, err := decodeCursor(query.Cursor, articleCursorScope)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+	keyset, err := timeKeyset(cursor)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	articles, hasMore, err := a.articleRepository.FindAllByCursor(articleListFilter(userID, query), keyset)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	positions := make([]utils.Cursor, 0, len(articles))
+	for _, article := range articles {
+		// Sama dengan urutan repository: published_at, atau created_at untuk artikel yang belum pernah terbit
+		key := article.CreatedAt
+		if article.PublishedAt != nil {
+			key = *article.PublishedAt
+		}
+		positions = append(positions, timeCursor(articleCursorScope, key, article.ID))
+	}
+
+	meta, err := utils.NewCursorMeta(query.Limit, cursor, positions, hasMore)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+	return articles, meta, nil
+}
+
+// Update implements ArticleService.
+func (a *articleService) Update(userID uint64, articleID uint64, request request.UpdateArticleRequest) (*entity.ArticleEntity, error) {
+	article, err := findArticle(a.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.AuthorID != userID {
+		return nil, ErrArticleForbidden
+	}
+
+	// Isi artikel hanya bisa diubah saat draft agar setiap perubahan yang terbit melewati review editor
+	if !isEditable(article) {
+		return nil, ErrArticleLocked
+	}
+
+	if err := a.ensureCategoryExists(request.CategoryID); err != nil {
+		return nil, err
+	}
+
+	// Tags nil berarti client tidak mengirim field tags, tag lama dipertahankan
+	if request.Tags != nil {
+		tags, err := a.resolveTags(request.Tags)
+		if err != nil {
+			return nil, err
+		}
+		article.Tags = tags
+	}
+
+	current := *article
+	article.CategoryID = request.CategoryID
+	article.Title = request.Title
+	article.Excerpt = buildExcerpt(request.Excerpt, request.Body)
+	article.Body = request.Body
+
+	updated, err := saveWithUniqueSlug(a.articleRepository, request.Title, &current, func(slug string) (*entity.ArticleEntity, error) {
+		article.Slug = slug
+		return a.articleRepository.Update(*article, repository.RevisionInfo{EditorID: userID})
+	})
+	if err != nil {
+		log.Println("Error updating article:", err)
+		return nil, err
+	}
+
+	// Hanya draft yang bisa diedit, sync memastikan indeks tetap sama dengan status artikel
+	syncSearchIndex(a.searchIndex, updated)
+	return updated, nil
+}
+
+// isEditable menandakan isi artikel boleh diubah, artikel yang direview, terjadwal, terbit atau diarsipkan dikunci
+func isEditable(article *entity.ArticleEntity) bool {
+	return article.Status == model.ArticleStatusDraft
+}
+
+// Delete implements ArticleService.
+func (a *articleService) Delete(userID uint64, articleID uint64) error {
+	article, err := findArticle(a.articleRepository, articleID)
+	if err != nil {
+		return err
+	}
+
+	if article.AuthorID != userID {
+		return ErrArticleForbidden
+	}
+
+	if err := a.articleRepository.Delete(article.ID); err != nil {
+		return err
+	}
+	removeFromSearchIndex(a.searchIndex, article.ID)
+	return nil
+}
+
+// ForceDelete implements ArticleService.
+func (a *articleService) ForceDelete(articleID uint64) error {
+	article, err := findArticle(a.articleRepository, articleID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.articleRepository.Delete(article.ID); err != nil {
+		return err
+	}
+	removeFromSearchIndex(a.searchIndex, article.ID)
+	return nil
+}
+
+// articleListFilter membuat filter daftar artikel, default hanya artikel terbit
+func articleListFilter(userID uint64, query request.ArticleListQuery) repository.ArticleFilter {
+	filter := repository.ArticleFilter{
+		Status: model.ArticleStatusPublished,
+		Page:   query.Page,
+		Limit:  query.Limit,
+	}
+
+	// Mode "mine" menampilkan semua artikel milik user, termasuk draft
+	if query.Mine {
+		filter.AuthorID = userID
+		filter.Status = query.Status
+	}
+	filter.TagSlug = utils.Slugify(query.Tag)
+
+	return filter
+}
+
+// ensureCategoryExists memastikan kategori yang dipilih ada, nil berarti tanpa kategori
+func (a *articleService) ensureCategoryExists(categoryID *uint64) error {
+	if categoryID == nil {
+		return nil
+	}
+
+	if _, err := a.categoryRepository.FindByID(*categoryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCategoryNotFound.WithDetails(map[string]string{"category_id": "Category not found"})
+		}
+		return err
+	}
+	return nil
+}
+
+// resolveTags membuat tag yang belum ada dan mengembalikan tag untuk disimpan ke artikel
+func (a *articleService) resolveTags(names []string) ([]entity.ArticleTag, error) {
+	tags, err := a.tagRepository.FindOrCreate(buildTags(names))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.ArticleTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, entity.ArticleTag{ID: tag.ID, Name: tag.Name, Slug: tag.Slug})
+	}
+	return result, nil
+}
+
+// findArticle mengambil artikel dan menerjemahkan record not found menjadi error yang mudah dibaca
+func findArticle(articleRepository repository.ArticleRepository, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := articleRepository.FindByID(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrArticleNotFound
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// canViewArticle memeriksa apakah user boleh melihat artikel, artikel yang belum terbit
+// hanya boleh dilihat oleh author-nya sendiri (userID 0 berarti pengunjung tanpa login)
+func canViewArticle(article *entity.ArticleEntity, userID uint64) bool {
+	return article.Status == model.ArticleStatusPublished || (userID != 0 && article.AuthorID == userID)
+}
+
+// buildExcerpt menggunakan excerpt dari request, atau memotong body jika excerpt tidak diisi
+func buildExcerpt(excerpt *string, body string) *string {
+	if excerpt != nil && strings.TrimSpace(*excerpt) != "" {
+		return excerpt
+	}
+
+	text := strings.Join(strings.Fields(body), " ")
+	if utf8.RuneCountInString(text) > excerptLength {
+		text = string([]rune(text)[:excerptLength]) + "..."
+	}
+	return &text
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-article/internal/domain/entity"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// maxSlugAttempts adalah batas percobaan menyimpan artikel saat slug yang dipilih
+// direbut request lain di antara pengecekan dan insert
+const maxSlugAttempts = 5
+
+// articleSlugBase membuat slug dasar dari judul artikel
+func articleSlugBase(title string) string {
+	base := utils.Slugify(title)
+	if base == "" {
+		return "article"
+	}
+
+	// Slug yang seluruhnya angka akan dikira ID oleh GET /articles/:id
+	if _, err := strconv.ParseUint(base, 10, 64); err == nil {
+		return "article-" + base
+	}
+	return base
+}
+
+// slugMatchesBase memeriksa apakah slug berasal dari base, yaitu sama persis atau base-N
+func slugMatchesBase(slug string, base string) bool {
+	if slug == base {
+		return true
+	}
+
+	suffix, ok := strings.CutPrefix(slug, base+"-")
+	if !ok {
+		return false
+	}
+	_, err := strconv.ParseUint(suffix, 10, 64)
+	return err == nil
+}
+
+// nextFreeSlug memilih base atau base-N dengan N terkecil yang belum dipakai artikel lain
+func nextFreeSlug(articleRepository repository.ArticleRepository, base string, excludeArticleID uint64) (string, error) {
+	slugs, err := articleRepository.FindTakenSlugs(base, excludeArticleID)
+	if err != nil {
+		return "", err
+	}
+
+	taken := make(map[string]bool, len(slugs))
+	for _, slug := range slugs {
+		taken[slug] = true
+	}
+
+	slug := base
+	for i := 2; taken[slug]; i++ {
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+	return slug, nil
+}
+
+// saveWithUniqueSlug menentukan slug dari judul lalu memanggil save.
+// Slug artikel yang sudah ada dipertahankan selama judul barunya menghasilkan slug dasar yang sama,
+// sehingga URL tidak berubah hanya karena perbedaan huruf besar atau tanda baca.
+// Jika unique index menolak slug karena request paralel memakai slug yang sama, slug berikutnya dicoba.
+// Parameter: current adalah artikel sebelum diubah, nil untuk artikel baru
+func saveWithUniqueSlug(articleRepository repository.ArticleRepository, title string, current *entity.ArticleEntity, save func(slug string) (*entity.ArticleEntity, error)) (*entity.ArticleEntity, error) {
+	base := articleSlugBase(title)
+
+	var excludeArticleID uint64
+	if current != nil {
+		if slugMatchesBase(current.Slug, base) {
+			return save(current.Slug)
+		}
+		excludeArticleID = current.ID
+	}
+
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		slug, err := nextFreeSlug(articleRepository, base, excludeArticleID)
+		if err != nil {
+			return nil, err
+		}
+
+		article, err := save(slug)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			continue
+		}
+		return article, err
+	}
+
+	return nil, ErrArticleSlugConflict
+}
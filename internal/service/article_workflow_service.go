@@ -0,0 +1,280 @@
+package service
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/internal/search"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// articleTransitions adalah state machine artikel: setiap aksi hanya boleh dijalankan dari status tertentu.
+//
+//	draft --submit--> in_review --approve--> published | scheduled --publish--> published --archive--> archived
+//	                  in_review --reject---> draft
+//	                  published --revise---> draft
+var articleTransitions = map[string][]string{
+	model.ArticleActionSubmit:  {model.ArticleStatusDraft},
+	model.ArticleActionApprove: {model.ArticleStatusInReview},
+	model.ArticleActionReject:  {model.ArticleStatusInReview},
+	model.ArticleActionPublish: {model.ArticleStatusScheduled},
+	model.ArticleActionArchive: {model.ArticleStatusPublished},
+	model.ArticleActionRevise:  {model.ArticleStatusPublished},
+}
+
+type ArticleWorkflowService interface {
+	Submit(authorID uint64, articleID uint64) (*entity.ArticleEntity, error)
+	Approve(editorID uint64, articleID uint64, request request.ApproveArticleRequest) (*entity.ArticleEntity, error)
+	Reject(editorID uint64, articleID uint64, request request.RejectArticleRequest) (*entity.ArticleEntity, error)
+	// Publish menerbitkan artikel terjadwal, actorID nil berarti dijalankan oleh sistem
+	Publish(actorID *uint64, articleID uint64) (*entity.ArticleEntity, error)
+	Archive(actorID uint64, articleID uint64, asEditor bool) (*entity.ArticleEntity, error)
+	// Revise menarik artikel terbit milik author kembali menjadi draft agar isinya bisa diubah dan direview ulang
+	Revise(authorID uint64, articleID uint64) (*entity.ArticleEntity, error)
+	History(userID uint64, articleID uint64, asEditor bool) ([]entity.ArticleTransitionEntity, error)
+	ReviewQueue(query request.ReviewQueueQuery) ([]entity.ArticleEntity, int64, error)
+	GetForReview(articleID uint64) (*entity.ArticleEntity, error)
+	// PublishDue menerbitkan satu batch artikel terjadwal yang sudah jatuh tempo, dipanggil oleh scheduler
+	PublishDue(limit int) (int, error)
+}
+
+type articleWorkflowService struct {
+	articleRepository repository.ArticleRepository
+	userRepository    repository.UserRepository
+	publishNotifier   *PublishNotifier
+	searchIndex       search.SearchIndex
+}
+
+func NewArticleWorkflowService(articleRepo repository.ArticleRepository, userRepo repository.UserRepository, publishNotifier *PublishNotifier, searchIndex search.SearchIndex) ArticleWorkflowService {
+	return &articleWorkflowService{
+		articleRepository: articleRepo,
+		userRepository:    userRepo,
+		publishNotifier:   publishNotifier,
+		searchIndex:       searchIndex,
+	}
+}
+
+// Submit implements ArticleWorkflowService.
+func (w *articleWorkflowService) Submit(authorID uint64, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.AuthorID != authorID {
+		return nil, ErrArticleForbidden
+	}
+
+	if err := w.ensureCanPublish(authorID); err != nil {
+		return nil, err
+	}
+
+	return w.transition(article, model.ArticleActionSubmit, model.ArticleStatusInReview, &authorID, article.PublishedAt, nil)
+}
+
+// Approve implements ArticleWorkflowService.
+func (w *articleWorkflowService) Approve(editorID uint64, articleID uint64, request request.ApproveArticleRequest) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	toStatus := model.ArticleStatusPublished
+	publishedAt := &now
+
+	if request.PublishAt != nil {
+		if !request.PublishAt.After(now) {
+			return nil, ErrInvalidPublishAt
+		}
+		toStatus = model.ArticleStatusScheduled
+		publishedAt = request.PublishAt
+	}
+
+	return w.transition(article, model.ArticleActionApprove, toStatus, &editorID, publishedAt, request.Comment)
+}
+
+// Reject implements ArticleWorkflowService.
+func (w *articleWorkflowService) Reject(editorID uint64, articleID uint64, request request.RejectArticleRequest) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Artikel kembali menjadi draft agar author bisa memperbaikinya sesuai komentar editor
+	return w.transition(article, model.ArticleActionReject, model.ArticleStatusDraft, &editorID, article.PublishedAt, &request.Comment)
+}
+
+// Publish implements ArticleWorkflowService.
+func (w *articleWorkflowService) Publish(actorID *uint64, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jadwal dipertahankan jika sudah lewat, publikasi lebih awal memakai waktu sekarang
+	publishedAt := article.PublishedAt
+	if now := time.Now(); publishedAt == nil || publishedAt.After(now) {
+		publishedAt = &now
+	}
+
+	return w.transition(article, model.ArticleActionPublish, model.ArticleStatusPublished, actorID, publishedAt, nil)
+}
+
+// Archive implements ArticleWorkflowService.
+func (w *articleWorkflowService) Archive(actorID uint64, articleID uint64, asEditor bool) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !asEditor && article.AuthorID != actorID {
+		return nil, ErrArticleForbidden
+	}
+
+	return w.transition(article, model.ArticleActionArchive, model.ArticleStatusArchived, &actorID, article.PublishedAt, nil)
+}
+
+// Revise implements ArticleWorkflowService.
+func (w *articleWorkflowService) Revise(authorID uint64, articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.AuthorID != authorID {
+		return nil, ErrArticleForbidden
+	}
+
+	// Artikel keluar dari publik dan indeks pencarian sampai diajukan dan disetujui lagi
+	return w.transition(article, model.ArticleActionRevise, model.ArticleStatusDraft, &authorID, article.PublishedAt, nil)
+}
+
+// History implements ArticleWorkflowService.
+func (w *articleWorkflowService) History(userID uint64, articleID uint64, asEditor bool) ([]entity.ArticleTransitionEntity, error) {
+	article, err := findArticle(w.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !asEditor && article.AuthorID != userID {
+		return nil, ErrArticleForbidden
+	}
+
+	return w.articleRepository.FindTransitions(article.ID)
+}
+
+// ReviewQueue implements ArticleWorkflowService.
+func (w *articleWorkflowService) ReviewQueue(query request.ReviewQueueQuery) ([]entity.ArticleEntity, int64, error) {
+	filter := repository.ArticleFilter{
+		Status: query.Status,
+		Page:   query.Page,
+		Limit:  query.Limit,
+	}
+	return w.articleRepository.FindAll(filter)
+}
+
+// GetForReview implements ArticleWorkflowService.
+func (w *articleWorkflowService) GetForReview(articleID uint64) (*entity.ArticleEntity, error) {
+	return findArticle(w.articleRepository, articleID)
+}
+
+// PublishDue implements ArticleWorkflowService.
+func (w *articleWorkflowService) PublishDue(limit int) (int, error) {
+	ids, err := w.articleRepository.PublishDue(time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		article, err := w.articleRepository.FindByID(id)
+		if err != nil {
+			// Artikel sudah terbit, hanya hook-nya yang terlewat
+			log.Printf("[ArticleWorkflowService] PublishDue - fetching article %d: %v", id, err)
+			continue
+		}
+		syncSearchIndex(w.searchIndex, article)
+		w.publishNotifier.Notify(*article)
+	}
+
+	return len(ids), nil
+}
+
+// transition memvalidasi aksi terhadap state machine lalu menyimpan status baru beserta riwayatnya
+func (w *articleWorkflowService) transition(article *entity.ArticleEntity, action string, toStatus string, actorID *uint64, publishedAt *time.Time, comment *string) (*entity.ArticleEntity, error) {
+	if !canTransition(action, article.Status) {
+		return nil, invalidTransition(action, article.Status)
+	}
+
+	applied, err := w.articleRepository.Transition(entity.ArticleTransitionEntity{
+		ArticleID:  article.ID,
+		Action:     action,
+		FromStatus: article.Status,
+		ToStatus:   toStatus,
+		ActorID:    actorID,
+		Comment:    comment,
+	}, publishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Status sudah diubah oleh request lain di antara pembacaan dan penulisan
+	if !applied {
+		current, err := findArticle(w.articleRepository, article.ID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, invalidTransition(action, current.Status)
+	}
+
+	updated, err := findArticle(w.articleRepository, article.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Artikel masuk indeks saat terbit dan keluar saat diarsipkan
+	syncSearchIndex(w.searchIndex, updated)
+	if updated.Status == model.ArticleStatusPublished {
+		w.publishNotifier.Notify(*updated)
+	}
+	return updated, nil
+}
+
+// ensureCanPublish menolak pengajuan dari user yang emailnya belum terverifikasi
+// jika env REQUIRE_VERIFIED_TO_PUBLISH bernilai true
+func (w *articleWorkflowService) ensureCanPublish(userID uint64) error {
+	if required, _ := strconv.ParseBool(os.Getenv("REQUIRE_VERIFIED_TO_PUBLISH")); !required {
+		return nil
+	}
+
+	user, err := w.userRepository.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.VerifiedAt == nil {
+		return ErrEmailNotVerified
+	}
+	return nil
+}
+
+// canTransition memeriksa apakah aksi boleh dijalankan dari status saat ini
+func canTransition(action string, fromStatus string) bool {
+	for _, status := range articleTransitions[action] {
+		if status == fromStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidTransition membuat ErrInvalidArticleTransition dengan status dan aksi yang ditolak
+func invalidTransition(action string, fromStatus string) error {
+	return ErrInvalidArticleTransition.WithDetails(map[string]string{
+		"action": action,
+		"status": fromStatus,
+	})
+}
@@ -2,29 +2,60 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"go-article/internal/auth"
 	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
 	"go-article/internal/handler/request"
 	"go-article/internal/repository"
+	"go-article/pkg/mailer"
 	"go-article/pkg/utils"
 	"log"
+	"net/url"
+	"os"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// forgotPasswordMinDuration adalah durasi minimal response forgot password
+const forgotPasswordMinDuration = 500 * time.Millisecond
+
 type AuthService interface {
 	Register(request request.RegisterRequest) (*entity.UserEntity, error)
-	Login(request request.LoginRequest) (*entity.UserEntity, string, error)
+	// Login memverifikasi kredensial dan mencatat setiap percobaan beserta IP dan user agent client
+	Login(request request.LoginRequest, ipAddress string, userAgent string) (*entity.UserEntity, *entity.AuthToken, error)
 	Profile(userID uint64) (*entity.UserEntity, error)
+	Refresh(refreshToken string) (*entity.AuthToken, error)
+	// Logout mencabut sesi refresh token, access token yang ikut dikirim dimasukkan ke denylist
+	Logout(refreshToken string, accessToken string) error
+	LogoutAll(userID uint64) error
+	VerifyEmail(token string) error
+	ResendVerification(email string) error
+	ForgotPassword(email string) error
+	ResetPassword(token string, newPassword string) error
 }
 
 type authService struct {
-	userRepository repository.UserRepository
+	userRepository         repository.UserRepository
+	roleRepository         repository.RoleRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	passwordResetRepo      repository.PasswordResetRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	sessionService         SessionService
+	mailer                 mailer.Mailer
 }
 
-func NewAuthService(userRepo repository.UserRepository) AuthService {
+func NewAuthService(userRepo repository.UserRepository, roleRepo repository.RoleRepository, refreshTokenRepo repository.RefreshTokenRepository, passwordResetRepo repository.PasswordResetRepository, loginAttemptRepo repository.LoginAttemptRepository, sessionService SessionService, mailer mailer.Mailer) AuthService {
 	return &authService{
-		userRepository: userRepo,
+		userRepository:         userRepo,
+		roleRepository:         roleRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		passwordResetRepo:      passwordResetRepo,
+		loginAttemptRepository: loginAttemptRepo,
+		sessionService:         sessionService,
+		mailer:                 mailer,
 	}
 }
 
@@ -33,38 +64,211 @@ func (a *authService) Profile(userID uint64) (*entity.UserEntity, error) {
 	user, err := a.userRepository.FindByID(userID)
 	if err != nil {
 		log.Println("Error fetching user in Profile:", err)
-		return user, err
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
 	}
 	return user, nil
 }
 
 // Login implements AuthService.
-func (a *authService) Login(request request.LoginRequest) (*entity.UserEntity, string, error) {
-	// Cari user berdasarkan email
-	user, err := a.userRepository.FindByEmail(request.Email)
-	if err != nil {
-		return user, "", errors.New("invalid email or password")
+func (a *authService) Login(request request.LoginRequest, ipAddress string, userAgent string) (*entity.UserEntity, *entity.AuthToken, error) {
+	email := strings.ToLower(strings.TrimSpace(request.Email))
+	now := time.Now()
+
+	// IP yang terlalu sering gagal ditahan dulu, apa pun akun yang dicoba
+	if until := a.ipBlockedUntil(ipAddress, now); until != nil {
+		a.recordLoginAttempt(nil, email, ipAddress, userAgent, model.LoginResultThrottled)
+		return nil, nil, retryAfterError(ErrLoginThrottled, *until, now)
 	}
 
-	// Periksa apakah user ditemukan
-	if user.ID == 0 {
-		return user, "", errors.New("invalid email or password")
+	// Cari user berdasarkan email. Email yang tidak terdaftar diperlakukan sama seperti akun yang ada:
+	// ikut dikunci setelah gagal berulang kali dan password tetap dicocokkan dengan bcrypt
+	user, err := a.userRepository.FindByEmail(email)
+	if err != nil || user.ID == 0 {
+		if until := a.unknownEmailLockedUntil(email, now); until != nil {
+			a.recordLoginAttempt(nil, email, ipAddress, userAgent, model.LoginResultLocked)
+			return nil, nil, retryAfterError(ErrAccountLocked, *until, now)
+		}
+		utils.CheckPasswordHash(request.Password, dummyPasswordHash())
+		a.recordLoginAttempt(nil, email, ipAddress, userAgent, model.LoginResultInvalidCredentials)
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	// Akun yang sedang ditahan ditolak tanpa memeriksa password agar tebakan tidak bisa diteruskan
+	if user.LockedUntil != nil && now.Before(*user.LockedUntil) {
+		a.recordLoginAttempt(&user.ID, email, ipAddress, userAgent, model.LoginResultLocked)
+		return user, nil, retryAfterError(ErrAccountLocked, *user.LockedUntil, now)
 	}
 
-	// Verifikasi password
+	// Verifikasi password, setiap kegagalan memperpanjang jeda login berikutnya
 	if !utils.CheckPasswordHash(request.Password, user.Password) {
-		return user, "", errors.New("invalid email or password")
+		a.recordLoginAttempt(&user.ID, email, ipAddress, userAgent, model.LoginResultInvalidCredentials)
+		a.recordLoginFailure(user.ID, now)
+		return user, nil, ErrInvalidCredentials
+	}
+
+	// Akun yang di-suspend admin tidak boleh login
+	if user.Status == model.UserStatusSuspended {
+		a.recordLoginAttempt(&user.ID, email, ipAddress, userAgent, model.LoginResultSuspended)
+		return user, nil, ErrAccountSuspended
 	}
 
-	// Generate token JWT
-	token, err := utils.GenerateToken(uint(user.ID))
+	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
+		if err := a.userRepository.ResetLoginFailures(user.ID); err != nil {
+			return user, nil, err
+		}
+		user.FailedLoginAttempts = 0
+		user.LockedUntil = nil
+	}
+	a.recordLoginAttempt(&user.ID, email, ipAddress, userAgent, model.LoginResultSuccess)
+
+	// Setiap login memulai family refresh token baru
+	familyID, err := utils.GenerateRandomID(16)
 	if err != nil {
-		return user, "", err
+		return user, nil, err
+	}
+
+	token, refreshToken, err := a.newTokenPair(user, familyID)
+	if err != nil {
+		return user, nil, err
+	}
+
+	if err := a.refreshTokenRepository.Create(refreshToken); err != nil {
+		return user, nil, err
 	}
 
 	return user, token, nil
 }
 
+// Refresh implements AuthService.
+func (a *authService) Refresh(refreshToken string) (*entity.AuthToken, error) {
+	current, err := a.refreshTokenRepository.FindByHash(utils.HashToken(refreshToken))
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Token yang sudah dirotasi dipakai lagi: kemungkinan dicuri, cabut seluruh sesi
+	if current.RevokedAt != nil {
+		a.revokeFamily(current.FamilyID)
+		return nil, ErrRefreshTokenReused
+	}
+
+	if time.Now().After(current.ExpiresAt) {
+		return nil, ErrRefreshTokenExpired
+	}
+
+	// User yang sudah dihapus atau di-suspend tidak boleh memperpanjang sesi
+	user, err := a.userRepository.FindByID(current.UserID)
+	if err != nil || user.Status == model.UserStatusSuspended {
+		a.revokeFamily(current.FamilyID)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	token, next, err := a.newTokenPair(user, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := a.refreshTokenRepository.Rotate(current.ID, next)
+	if err != nil {
+		return nil, err
+	}
+
+	// Request lain sudah merotasi token ini lebih dulu, perlakukan sebagai reuse
+	if !rotated {
+		a.revokeFamily(current.FamilyID)
+		return nil, ErrRefreshTokenReused
+	}
+
+	return token, nil
+}
+
+// Logout implements AuthService.
+func (a *authService) Logout(refreshToken string, accessToken string) error {
+	current, err := a.refreshTokenRepository.FindByHash(utils.HashToken(refreshToken))
+	if err != nil {
+		return ErrInvalidRefreshToken
+	}
+
+	if err := a.refreshTokenRepository.RevokeFamily(current.FamilyID); err != nil {
+		return err
+	}
+
+	// Access token bersifat opsional, token yang tidak valid atau sudah kedaluwarsa tidak perlu dicabut
+	if accessToken == "" {
+		return nil
+	}
+	claims, err := utils.ValidateToken(accessToken)
+	if err != nil {
+		return nil
+	}
+	principal, err := auth.NewPrincipal(claims)
+	if err != nil || principal.UserID != current.UserID {
+		return nil
+	}
+	return a.sessionService.RevokeToken(principal)
+}
+
+// LogoutAll implements AuthService.
+func (a *authService) LogoutAll(userID uint64) error {
+	return a.sessionService.RevokeAll(userID)
+}
+
+// newTokenPair membuat access token JWT dan refresh token baru dalam family yang sama
+func (a *authService) newTokenPair(user *entity.UserEntity, familyID string) (*entity.AuthToken, *model.RefreshToken, error) {
+	roles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	accessToken, _, err := utils.GenerateToken(utils.TokenSubject{UserID: user.ID, Roles: roles, TokenVersion: user.TokenVersion})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plainRefreshToken, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken := &model.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: utils.HashToken(plainRefreshToken),
+		ExpiresAt: time.Now().Add(utils.RefreshTokenTTL()),
+	}
+
+	token := &entity.AuthToken{
+		AccessToken:  accessToken,
+		RefreshToken: plainRefreshToken,
+		ExpiresIn:    int64(utils.AccessTokenTTL().Seconds()),
+	}
+
+	return token, refreshToken, nil
+}
+
+// recordLoginFailure menambah counter gagal login user lalu menahan akun sesuai backoff-nya
+func (a *authService) recordLoginFailure(userID uint64, now time.Time) {
+	attempts, err := a.userRepository.RecordLoginFailure(userID)
+	if err != nil {
+		log.Println("Error recording login failure:", err)
+		return
+	}
+
+	if err := a.userRepository.LockUntil(userID, now.Add(accountLockDuration(attempts))); err != nil {
+		log.Println("Error locking account:", err)
+	}
+}
+
+// revokeFamily mencabut satu family refresh token dan hanya mencatat error-nya
+func (a *authService) revokeFamily(familyID string) {
+	if err := a.refreshTokenRepository.RevokeFamily(familyID); err != nil {
+		log.Println("Error revoking refresh token family:", err)
+	}
+}
+
 // Register implements AuthService.
 func (a *authService) Register(request request.RegisterRequest) (*entity.UserEntity, error) {
 	user := entity.UserEntity{}
@@ -80,28 +284,185 @@ func (a *authService) Register(request request.RegisterRequest) (*entity.UserEnt
 
 	user.Password = hashedPassword
 
-	// Validasi role IDs ada di database
-	if len(request.RoleIDs) > 0 {
-		roles, err := a.userRepository.GetRolesByIDs(request.RoleIDs)
-		if err != nil {
-			log.Println("Error fetching roles:", err)
-			return nil, err
-		}
-
-		// Cek apakah jumlah role yang ditemukan sama dengan request
-		if len(roles) != len(request.RoleIDs) {
-			return nil, errors.New("role IDs are invalid")
-		}
+	// User hasil registrasi mandiri selalu mendapat role default, bukan role pilihan sendiri
+	defaultRole, err := a.roleRepository.FindByName(defaultRoleName())
+	if err != nil {
+		log.Println("Error fetching default role:", err)
+		return nil, ErrDefaultRoleMissing
 	}
 
-	newUser, err := a.userRepository.CreateWithRoles(user, request.RoleIDs)
+	newUser, err := a.userRepository.CreateWithRoles(user, []uint64{defaultRole.ID})
 	if err != nil {
 		// Cek apakah error adalah duplicate key constraint
-		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "Duplicate entry") || strings.Contains(err.Error(), "UNIQUE constraint failed") {
-			return nil, errors.New("email already registered")
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrEmailAlreadyRegistered
 		}
 		return nil, err
 	}
 
+	// Kegagalan kirim email tidak membatalkan registrasi, user bisa meminta kirim ulang
+	if err := a.sendVerificationEmail(newUser); err != nil {
+		log.Println("Error sending verification email:", err)
+	}
+
 	return newUser, nil
 }
+
+// VerifyEmail implements AuthService.
+func (a *authService) VerifyEmail(token string) error {
+	userID, email, err := utils.ParseVerificationToken(token)
+	if err != nil {
+		return ErrInvalidVerificationToken
+	}
+
+	user, err := a.userRepository.FindByID(userID)
+	if err != nil || user.Email != email {
+		return ErrInvalidVerificationToken
+	}
+
+	if user.VerifiedAt != nil {
+		return nil
+	}
+
+	return a.userRepository.MarkVerified(user.ID, time.Now())
+}
+
+// ResendVerification implements AuthService.
+func (a *authService) ResendVerification(email string) error {
+	// Email yang tidak terdaftar atau sudah terverifikasi diabaikan agar tidak membocorkan data user
+	user, err := a.userRepository.FindByEmail(email)
+	if err != nil || user.VerifiedAt != nil {
+		return nil
+	}
+
+	return a.sendVerificationEmail(user)
+}
+
+// ForgotPassword implements AuthService.
+func (a *authService) ForgotPassword(email string) error {
+	// Samakan durasi response untuk email terdaftar maupun tidak agar tidak bisa ditebak dari waktunya
+	defer padDuration(time.Now(), forgotPasswordMinDuration)
+
+	// Token tetap dibuat walaupun email tidak terdaftar agar pekerjaan yang dilakukan setara
+	plainToken, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return err
+	}
+	tokenHash := utils.HashToken(plainToken)
+
+	user, err := a.userRepository.FindByEmail(email)
+	if err != nil {
+		return nil
+	}
+
+	// Hanya token terbaru yang berlaku
+	if err := a.passwordResetRepo.InvalidateByUser(user.ID); err != nil {
+		return err
+	}
+
+	resetToken := &model.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(utils.PasswordResetTokenTTL()),
+	}
+	if err := a.passwordResetRepo.Create(resetToken); err != nil {
+		return err
+	}
+
+	// Email dikirim di background agar latensi SMTP tidak mempengaruhi waktu response
+	go func(user entity.UserEntity) {
+		link := appURL() + "/reset-password?token=" + url.QueryEscape(plainToken)
+		err := a.mailer.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Use the link below to choose a new one:\n\n%s\n\nThis link expires in %s and can only be used once. If you did not request this, you can ignore this email.\n",
+				user.Name, link, utils.PasswordResetTokenTTL()),
+		})
+		if err != nil {
+			log.Println("Error sending password reset email:", err)
+		}
+	}(*user)
+
+	return nil
+}
+
+// ResetPassword implements AuthService.
+func (a *authService) ResetPassword(token string, newPassword string) error {
+	resetToken, err := a.passwordResetRepo.FindByHash(utils.HashToken(token))
+	if err != nil || resetToken.UsedAt != nil || time.Now().After(resetToken.ExpiresAt) {
+		return ErrInvalidResetToken
+	}
+
+	// Tandai terpakai lebih dulu agar token tidak bisa dipakai dua kali secara bersamaan
+	used, err := a.passwordResetRepo.MarkUsed(resetToken.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidResetToken
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		log.Println("Error hashing password:", err)
+		return err
+	}
+
+	if err := a.userRepository.UpdatePassword(resetToken.UserID, hashedPassword); err != nil {
+		return err
+	}
+
+	// Akhiri semua sesi yang masih aktif setelah password diganti, termasuk access token yang sudah terbit
+	if err := a.sessionService.RevokeAll(resetToken.UserID); err != nil {
+		return err
+	}
+
+	return a.passwordResetRepo.InvalidateByUser(resetToken.UserID)
+}
+
+// sendVerificationEmail membuat token verifikasi dan mengirimkannya ke email user
+func (a *authService) sendVerificationEmail(user *entity.UserEntity) error {
+	token, err := utils.GenerateVerificationToken(user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	link := appURL() + "/auth/verify?token=" + url.QueryEscape(token)
+	return a.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThis link expires in %s.\n",
+			user.Name, link, utils.VerificationTokenTTL()),
+	})
+}
+
+// defaultRoleName mengembalikan nama role untuk user baru, bisa diubah lewat env DEFAULT_ROLE
+func defaultRoleName() string {
+	if role := os.Getenv("DEFAULT_ROLE"); role != "" {
+		return role
+	}
+	return model.RoleUser
+}
+
+// padDuration menunggu sampai minimal durasi minimum berlalu sejak start
+func padDuration(start time.Time, minimum time.Duration) {
+	if remaining := minimum - time.Since(start); remaining > 0 {
+		time.Sleep(remaining)
+	}
+}
+
+// appURL mengembalikan base URL aplikasi dari env APP_URL untuk link di email
+func appURL() string {
+	if value := os.Getenv("APP_URL"); value != "" {
+		return strings.TrimRight(value, "/")
+	}
+	return "http://localhost:" + envOrDefault("PORT", "8080")
+}
+
+// envOrDefault membaca env dan memakai fallback jika kosong
+func envOrDefault(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
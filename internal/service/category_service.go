@@ -0,0 +1,198 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type CategoryService interface {
+	Tree() ([]entity.CategoryEntity, error)
+	GetByID(categoryID uint64) (*entity.CategoryEntity, error)
+	Create(request request.CategoryRequest) (*entity.CategoryEntity, error)
+	Update(categoryID uint64, request request.CategoryRequest) (*entity.CategoryEntity, error)
+	Delete(categoryID uint64) error
+	ListArticles(slug string, query request.CategoryArticleListQuery) ([]entity.ArticleEntity, int64, error)
+}
+
+type categoryService struct {
+	categoryRepository repository.CategoryRepository
+	articleRepository  repository.ArticleRepository
+}
+
+func NewCategoryService(categoryRepo repository.CategoryRepository, articleRepo repository.ArticleRepository) CategoryService {
+	return &categoryService{
+		categoryRepository: categoryRepo,
+		articleRepository:  articleRepo,
+	}
+}
+
+// Tree implements CategoryService.
+func (s *categoryService) Tree() ([]entity.CategoryEntity, error) {
+	return s.categoryRepository.FindTree()
+}
+
+// GetByID implements CategoryService.
+func (s *categoryService) GetByID(categoryID uint64) (*entity.CategoryEntity, error) {
+	category, err := s.categoryRepository.FindSubtree(categoryID)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return category, nil
+}
+
+// Create implements CategoryService.
+func (s *categoryService) Create(request request.CategoryRequest) (*entity.CategoryEntity, error) {
+	if request.ParentID != nil {
+		if _, err := s.categoryRepository.FindByID(*request.ParentID); err != nil {
+			return nil, parentError(err)
+		}
+	}
+
+	slug, err := s.uniqueSlug(request.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	category := entity.CategoryEntity{
+		ParentID:    request.ParentID,
+		Name:        request.Name,
+		Slug:        slug,
+		Description: request.Description,
+		Position:    request.Position,
+	}
+
+	newCategory, err := s.categoryRepository.Create(category)
+	if err != nil {
+		log.Println("Error creating category:", err)
+		return nil, err
+	}
+
+	return newCategory, nil
+}
+
+// Update implements CategoryService.
+func (s *categoryService) Update(categoryID uint64, request request.CategoryRequest) (*entity.CategoryEntity, error) {
+	category, err := s.categoryRepository.FindByID(categoryID)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+
+	if request.ParentID != nil {
+		parent, err := s.categoryRepository.FindByID(*request.ParentID)
+		if err != nil {
+			return nil, parentError(err)
+		}
+
+		// Parent baru tidak boleh kategori itu sendiri atau salah satu turunannya
+		if strings.HasPrefix(parent.Path, category.Path) {
+			return nil, ErrInvalidCategoryParent
+		}
+	}
+
+	// Slug hanya dibuat ulang jika nama berubah agar URL kategori tetap stabil
+	if request.Name != category.Name {
+		slug, err := s.uniqueSlug(request.Name, category.ID)
+		if err != nil {
+			return nil, err
+		}
+		category.Slug = slug
+	}
+
+	category.ParentID = request.ParentID
+	category.Name = request.Name
+	category.Description = request.Description
+	category.Position = request.Position
+
+	updated, err := s.categoryRepository.Update(*category)
+	if err != nil {
+		log.Println("Error updating category:", err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Delete implements CategoryService.
+func (s *categoryService) Delete(categoryID uint64) error {
+	if _, err := s.categoryRepository.FindByID(categoryID); err != nil {
+		return categoryError(err)
+	}
+
+	children, err := s.categoryRepository.CountChildren(categoryID)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return ErrCategoryHasChildren
+	}
+
+	return s.categoryRepository.Delete(categoryID)
+}
+
+// ListArticles implements CategoryService.
+func (s *categoryService) ListArticles(slug string, query request.CategoryArticleListQuery) ([]entity.ArticleEntity, int64, error) {
+	category, err := s.categoryRepository.FindBySlug(slug)
+	if err != nil {
+		return nil, 0, categoryError(err)
+	}
+
+	// Artikel di kategori turunan ikut ditampilkan, misalnya Tech juga memuat artikel Go dan Web
+	categoryIDs, err := s.categoryRepository.FindDescendantIDs(category.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter := repository.ArticleFilter{
+		Status:      model.ArticleStatusPublished,
+		CategoryIDs: categoryIDs,
+		Page:        query.Page,
+		Limit:       query.Limit,
+	}
+
+	return s.articleRepository.FindAll(filter)
+}
+
+// uniqueSlug membuat slug dari nama kategori dan menambahkan suffix angka jika slug sudah dipakai
+func (s *categoryService) uniqueSlug(name string, excludeID uint64) (string, error) {
+	base := utils.Slugify(name)
+	if base == "" {
+		base = "category"
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		exists, err := s.categoryRepository.ExistsBySlug(slug, excludeID)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+// categoryError menerjemahkan record not found menjadi ErrCategoryNotFound
+func categoryError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCategoryNotFound
+	}
+	return err
+}
+
+// parentError menerjemahkan parent yang tidak ditemukan menjadi error validasi pada field parent_id
+func parentError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCategoryNotFound.WithDetails(map[string]string{"parent_id": "Parent category not found"})
+	}
+	return err
+}
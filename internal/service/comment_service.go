@@ -0,0 +1,240 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// defaultCommentEditWindow adalah batas waktu edit komentar jika COMMENT_EDIT_WINDOW tidak diisi
+const defaultCommentEditWindow = 15 * time.Minute
+
+type CommentService interface {
+	List(viewerID uint64, articleID uint64, query request.CommentListQuery) ([]entity.CommentEntity, int64, error)
+	// ListByCursor sama seperti List tetapi memakai pagination cursor pada komentar level teratas
+	ListByCursor(viewerID uint64, articleID uint64, query request.CommentListQuery) ([]entity.CommentEntity, utils.CursorMeta, error)
+	Create(userID uint64, articleID uint64, request request.CreateCommentRequest) (*entity.CommentEntity, error)
+	Update(userID uint64, articleID uint64, commentID uint64, request request.UpdateCommentRequest) (*entity.CommentEntity, error)
+	Delete(userID uint64, articleID uint64, commentID uint64) error
+	ModerationQueue(query request.CommentModerationQuery) ([]entity.CommentEntity, int64, error)
+	Moderate(moderatorID uint64, commentID uint64, status string) (*entity.CommentEntity, error)
+	ForceDelete(commentID uint64) error
+}
+
+type commentService struct {
+	commentRepository repository.CommentRepository
+	articleRepository repository.ArticleRepository
+	userRepository    repository.UserRepository
+}
+
+func NewCommentService(commentRepo repository.CommentRepository, articleRepo repository.ArticleRepository, userRepo repository.UserRepository) CommentService {
+	return &commentService{
+		commentRepository: commentRepo,
+		articleRepository: articleRepo,
+		userRepository:    userRepo,
+	}
+}
+
+// List implements CommentService.
+func (s *commentService) List(viewerID uint64, articleID uint64, query request.CommentListQuery) ([]entity.CommentEntity, int64, error) {
+	article, err := s.publishedArticle(articleID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.commentRepository.FindThreads(article.ID, viewerID, query.Page, query.Limit)
+}
+
+// ListByCursor implements CommentService.
+func (s *commentService) ListByCursor(viewerID uint64, articleID uint64, query request.CommentListQuery) ([]entity.CommentEntity, utils.CursorMeta, error) {
+	article, err := s.publishedArticle(articleID)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	// Cursor komentar hanya berlaku untuk artikel yang sama
+	scope := fmt.Sprintf("comments:%d", article.ID)
+	cursor, err := decodeCursor(query.Cursor, scope)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+	keyset, err := timeKeyset(cursor)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	threads, hasMore, err := s.commentRepository.FindThreadsByCursor(article.ID, viewerID, keyset, query.Limit)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+
+	positions := make([]utils.Cursor, 0, len(threads))
+	for _, thread := range threads {
+		positions = append(positions, timeCursor(scope, thread.CreatedAt, thread.ID))
+	}
+
+	meta, err := utils.NewCursorMeta(query.Limit, cursor, positions, hasMore)
+	if err != nil {
+		return nil, utils.CursorMeta{}, err
+	}
+	return threads, meta, nil
+}
+
+// Create implements CommentService.
+func (s *commentService) Create(userID uint64, articleID uint64, request request.CreateCommentRequest) (*entity.CommentEntity, error) {
+	article, err := s.publishedArticle(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := entity.CommentEntity{
+		ArticleID: article.ID,
+		UserID:    userID,
+		Body:      strings.TrimSpace(request.Body),
+		Status:    initialCommentStatus(user.VerifiedAt),
+	}
+
+	if request.ParentID != nil {
+		parent, err := s.commentRepository.FindByID(*request.ParentID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		// Balasan hanya boleh untuk komentar approved pada artikel yang sama
+		if parent == nil || parent.ArticleID != article.ID || parent.Status != model.CommentStatusApproved {
+			return nil, ErrInvalidCommentParent
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+	}
+
+	return s.commentRepository.Create(comment)
+}
+
+// Update implements CommentService.
+func (s *commentService) Update(userID uint64, articleID uint64, commentID uint64, request request.UpdateCommentRequest) (*entity.CommentEntity, error) {
+	comment, err := s.ownedComment(userID, articleID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Komentar yang sudah ditolak moderator tidak bisa diubah untuk menghindari moderasi
+	if comment.Status == model.CommentStatusRejected || comment.Status == model.CommentStatusSpam {
+		return nil, ErrCommentForbidden
+	}
+
+	window := utils.DurationFromEnv("COMMENT_EDIT_WINDOW", defaultCommentEditWindow)
+	if time.Since(comment.CreatedAt) > window {
+		return nil, ErrCommentEditWindowExpired
+	}
+
+	return s.commentRepository.UpdateBody(comment.ID, strings.TrimSpace(request.Body))
+}
+
+// Delete implements CommentService.
+func (s *commentService) Delete(userID uint64, articleID uint64, commentID uint64) error {
+	comment, err := s.ownedComment(userID, articleID, commentID)
+	if err != nil {
+		return err
+	}
+
+	return s.commentRepository.Delete(comment.ID)
+}
+
+// ModerationQueue implements CommentService.
+func (s *commentService) ModerationQueue(query request.CommentModerationQuery) ([]entity.CommentEntity, int64, error) {
+	filter := repository.CommentFilter{
+		Status:    query.Status,
+		ArticleID: query.ArticleID,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+	return s.commentRepository.FindAll(filter)
+}
+
+// Moderate implements CommentService.
+func (s *commentService) Moderate(moderatorID uint64, commentID uint64, status string) (*entity.CommentEntity, error) {
+	comment, err := s.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commentRepository.UpdateStatus(comment.ID, status, moderatorID)
+}
+
+// ForceDelete implements CommentService.
+func (s *commentService) ForceDelete(commentID uint64) error {
+	comment, err := s.findComment(commentID)
+	if err != nil {
+		return err
+	}
+
+	return s.commentRepository.Delete(comment.ID)
+}
+
+// publishedArticle mengambil artikel yang menerima komentar, yaitu artikel yang sudah terbit
+func (s *commentService) publishedArticle(articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.Status != model.ArticleStatusPublished {
+		return nil, ErrCommentsClosed
+	}
+	return article, nil
+}
+
+// ownedComment mengambil komentar pada artikel dan memastikan user adalah penulisnya
+func (s *commentService) ownedComment(userID uint64, articleID uint64, commentID uint64) (*entity.CommentEntity, error) {
+	comment, err := s.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.ArticleID != articleID {
+		return nil, ErrCommentNotFound
+	}
+	if comment.UserID != userID {
+		return nil, ErrCommentForbidden
+	}
+	return comment, nil
+}
+
+// findComment mengambil komentar dan menerjemahkan record not found menjadi ErrCommentNotFound
+func (s *commentService) findComment(commentID uint64) (*entity.CommentEntity, error) {
+	comment, err := s.commentRepository.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// initialCommentStatus menentukan status komentar baru. Komentar dari akun yang emailnya belum
+// terverifikasi masuk antrean moderasi, COMMENTS_MODERATE_ALL=true memoderasi semua komentar.
+func initialCommentStatus(verifiedAt *string) string {
+	if verifiedAt == nil || utils.BoolFromEnv("COMMENTS_MODERATE_ALL", false) {
+		return model.CommentStatusPending
+	}
+	return model.CommentStatusApproved
+}
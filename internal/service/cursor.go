@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"time"
+)
+
+// decodeCursor membaca cursor dari request, cursor kosong berarti halaman pertama.
+// Cursor yang rusak atau milik daftar lain diterjemahkan menjadi ErrInvalidCursor.
+func decodeCursor(token string, scope string) (*utils.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	cursor, err := utils.DecodeCursor(token, scope)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// timeKeyset mengubah cursor yang Key-nya berupa waktu menjadi posisi keyset repository
+func timeKeyset(cursor *utils.Cursor) (*repository.Keyset, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	key, err := cursor.Time()
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.Keyset{Key: key, ID: cursor.ID, Backward: cursor.Backward}, nil
+}
+
+// timeCursor membuat posisi cursor untuk item dengan kolom urutan berupa waktu
+func timeCursor(scope string, key time.Time, id uint64) utils.Cursor {
+	return utils.Cursor{Scope: scope, Key: utils.TimeCursorKey(key), ID: id}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"strings"
+)
+
+// EngagementService menangani reaksi dan bookmark artikel. Semua operasi tulis bersifat idempotent:
+// menambah reaksi yang sudah ada atau menghapus bookmark yang belum ada tetap dianggap berhasil.
+type EngagementService interface {
+	Summary(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error)
+	React(userID uint64, articleID uint64, reaction string) (*entity.ArticleEngagementEntity, error)
+	Unreact(userID uint64, articleID uint64, reaction string) (*entity.ArticleEngagementEntity, error)
+	Bookmark(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error)
+	Unbookmark(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error)
+	Bookmarks(userID uint64, query request.BookmarkListQuery) ([]entity.ArticleEntity, int64, error)
+}
+
+type engagementService struct {
+	articleRepository  repository.ArticleRepository
+	reactionRepository repository.ReactionRepository
+	bookmarkRepository repository.BookmarkRepository
+}
+
+func NewEngagementService(articleRepo repository.ArticleRepository, reactionRepo repository.ReactionRepository, bookmarkRepo repository.BookmarkRepository) EngagementService {
+	return &engagementService{
+		articleRepository:  articleRepo,
+		reactionRepository: reactionRepo,
+		bookmarkRepository: bookmarkRepo,
+	}
+}
+
+// Summary implements EngagementService.
+func (s *engagementService) Summary(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewArticle(article, userID) {
+		return nil, ErrArticleNotFound
+	}
+	return s.summary(userID, article.ID)
+}
+
+// React implements EngagementService.
+func (s *engagementService) React(userID uint64, articleID uint64, reaction string) (*entity.ArticleEngagementEntity, error) {
+	reaction, err := normalizeReaction(reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	article, err := s.publishedArticle(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.reactionRepository.Add(userID, article.ID, reaction); err != nil {
+		return nil, err
+	}
+	return s.summary(userID, article.ID)
+}
+
+// Unreact implements EngagementService.
+func (s *engagementService) Unreact(userID uint64, articleID uint64, reaction string) (*entity.ArticleEngagementEntity, error) {
+	reaction, err := normalizeReaction(reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reaksi tetap boleh dicabut meskipun artikel sudah tidak terbit lagi atau sudah dihapus
+	if _, err := s.reactionRepository.Remove(userID, articleID, reaction); err != nil {
+		return nil, err
+	}
+	return s.removalSummary(userID, articleID)
+}
+
+// Bookmark implements EngagementService.
+func (s *engagementService) Bookmark(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+	article, err := s.publishedArticle(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.bookmarkRepository.Add(userID, article.ID); err != nil {
+		return nil, err
+	}
+	return s.summary(userID, article.ID)
+}
+
+// Unbookmark implements EngagementService.
+func (s *engagementService) Unbookmark(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+	// Bookmark artikel yang sudah dihapus tetap bisa dibersihkan oleh pemiliknya
+	if _, err := s.bookmarkRepository.Remove(userID, articleID); err != nil {
+		return nil, err
+	}
+	return s.removalSummary(userID, articleID)
+}
+
+// Bookmarks implements EngagementService.
+func (s *engagementService) Bookmarks(userID uint64, query request.BookmarkListQuery) ([]entity.ArticleEntity, int64, error) {
+	return s.bookmarkRepository.FindArticles(userID, query.Page, query.Limit)
+}
+
+// publishedArticle mengambil artikel yang bisa diberi reaksi atau di-bookmark
+func (s *engagementService) publishedArticle(articleID uint64) (*entity.ArticleEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.Status != model.ArticleStatusPublished {
+		return nil, ErrArticleNotPublished
+	}
+	return article, nil
+}
+
+// summary mengambil counter terbaru artikel beserta reaksi dan status bookmark milik user
+func (s *engagementService) summary(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.ArticleEngagementEntity{
+		ArticleID:     article.ID,
+		Reactions:     article.Reactions,
+		BookmarkCount: article.BookmarkCount,
+	}
+	return s.withUserState(result, userID)
+}
+
+// removalSummary adalah ringkasan setelah reaksi atau bookmark dicabut. Counter artikel yang sudah dihapus
+// atau tidak boleh dilihat user tidak ditampilkan, sehingga hanya reaksi dan status bookmark user
+// yang tersisa yang dikembalikan, sama seperti GET yang menganggap artikel tersebut tidak ada.
+func (s *engagementService) removalSummary(userID uint64, articleID uint64) (*entity.ArticleEngagementEntity, error) {
+	article, err := findArticle(s.articleRepository, articleID)
+	if err != nil && !errors.Is(err, ErrArticleNotFound) {
+		return nil, err
+	}
+
+	result := &entity.ArticleEngagementEntity{ArticleID: articleID, Reactions: map[string]int64{}}
+	if article != nil && canViewArticle(article, userID) {
+		result.Reactions = article.Reactions
+		result.BookmarkCount = article.BookmarkCount
+	}
+	return s.withUserState(result, userID)
+}
+
+// withUserState melengkapi ringkasan dengan reaksi dan status bookmark milik user
+func (s *engagementService) withUserState(result *entity.ArticleEngagementEntity, userID uint64) (*entity.ArticleEngagementEntity, error) {
+	result.MyReactions = []string{}
+	if userID == 0 {
+		return result, nil
+	}
+
+	reactions, err := s.reactionRepository.FindByUser(userID, result.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+	result.MyReactions = append(result.MyReactions, reactions...)
+
+	if result.Bookmarked, err = s.bookmarkRepository.Exists(userID, result.ArticleID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// normalizeReaction memastikan reaksi termasuk dalam daftar model.Reactions
+func normalizeReaction(reaction string) (string, error) {
+	reaction = strings.ToLower(strings.TrimSpace(reaction))
+	if _, ok := model.ReactionCountColumns[reaction]; !ok {
+		return "", ErrInvalidReaction.WithDetails(map[string]string{
+			"reaction": "Reaction must be one of: " + strings.Join(model.Reactions, ", "),
+		})
+	}
+	return reaction, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"go-article/pkg/apperror"
+	"net/http"
+)
+
+// Error domain yang dikembalikan service. Handler cukup meneruskannya ke c.Error
+// dan middleware.ErrorHandler yang menerjemahkannya menjadi response.
+var (
+	ErrEmailAlreadyRegistered   = apperror.Conflict("EMAIL_ALREADY_REGISTERED", "Email already registered").WithDetails(map[string]string{"email": "Email already registered"})
+	ErrInvalidCredentials       = apperror.Unauthorized("INVALID_CREDENTIALS", "Invalid email or password")
+	ErrAccountSuspended         = apperror.Forbidden("ACCOUNT_SUSPENDED", "Account is suspended")
+	ErrAccountLocked            = apperror.TooManyRequests("ACCOUNT_LOCKED", "Too many failed login attempts, please try again later")
+	ErrLoginThrottled           = apperror.TooManyRequests("LOGIN_THROTTLED", "Too many failed login attempts from this address, please try again later")
+	ErrDefaultRoleMissing       = apperror.New(http.StatusInternalServerError, "DEFAULT_ROLE_MISSING", "Default role is not configured")
+	ErrInvalidRefreshToken      = apperror.Unauthorized("INVALID_REFRESH_TOKEN", "Invalid refresh token")
+	ErrRefreshTokenExpired      = apperror.Unauthorized("REFRESH_TOKEN_EXPIRED", "Refresh token expired")
+	ErrRefreshTokenReused       = apperror.Unauthorized("REFRESH_TOKEN_REUSED", "Refresh token reuse detected")
+	ErrTokenRevoked             = apperror.Unauthorized("TOKEN_REVOKED", "Token has been revoked, please sign in again")
+	ErrInvalidVerificationToken = apperror.BadRequest("INVALID_VERIFICATION_TOKEN", "Invalid or expired verification token")
+	ErrInvalidResetToken        = apperror.BadRequest("INVALID_RESET_TOKEN", "Invalid or expired reset token")
+
+	ErrUserNotFound      = apperror.NotFound("USER_NOT_FOUND", "User not found")
+	ErrIncorrectPassword = apperror.BadRequest("INCORRECT_PASSWORD", "Current password is incorrect").WithDetails(map[string]string{"current_password": "Current password is incorrect"})
+	ErrCannotDeleteSelf  = apperror.Forbidden("CANNOT_DELETE_SELF", "You cannot delete your own account from the admin panel")
+	ErrCannotSuspendSelf = apperror.Forbidden("CANNOT_SUSPEND_SELF", "You cannot suspend your own account")
+
+	ErrRoleNotFound         = apperror.NotFound("ROLE_NOT_FOUND", "Role not found")
+	ErrCannotRevokeOwnAdmin = apperror.Forbidden("CANNOT_REVOKE_OWN_ADMIN", "You cannot revoke your own admin role")
+
+	ErrArticleNotFound  = apperror.NotFound("ARTICLE_NOT_FOUND", "Article not found")
+	ErrArticleForbidden = apperror.Forbidden("ARTICLE_FORBIDDEN", "You are not allowed to modify this article")
+	ErrEmailNotVerified = apperror.Forbidden("EMAIL_NOT_VERIFIED", "Email must be verified before publishing")
+
+	ErrArticleSlugConflict = apperror.Conflict("ARTICLE_SLUG_CONFLICT", "Could not reserve a unique slug for this article, please try again")
+
+	ErrCategoryNotFound      = apperror.NotFound("CATEGORY_NOT_FOUND", "Category not found")
+	ErrCategoryHasChildren   = apperror.Conflict("CATEGORY_HAS_CHILDREN", "Category still has child categories")
+	ErrInvalidCategoryParent = apperror.UnprocessableEntity("INVALID_CATEGORY_PARENT", "Category cannot be moved under itself or its descendants").WithDetails(map[string]string{"parent_id": "Invalid parent category"})
+
+	ErrTagNotFound              = apperror.NotFound("TAG_NOT_FOUND", "Tag not found")
+	ErrInvalidTagName           = apperror.UnprocessableEntity("INVALID_TAG_NAME", "Tag name must contain at least one letter or number").WithDetails(map[string]string{"name": "Invalid tag name"})
+	ErrTagSlugTaken             = apperror.Conflict("TAG_ALREADY_EXISTS", "Another tag with the same name already exists")
+	ErrCannotMergeTagIntoItself = apperror.UnprocessableEntity("CANNOT_MERGE_TAG_INTO_ITSELF", "A tag cannot be merged into itself").WithDetails(map[string]string{"target_id": "Target tag must be different"})
+
+	ErrArticleLocked            = apperror.Conflict("ARTICLE_LOCKED", "Only draft articles can be edited, revise a published article to move it back to draft")
+	ErrInvalidArticleTransition = apperror.Conflict("INVALID_STATUS_TRANSITION", "This action is not allowed for the current article status")
+	ErrInvalidPublishAt         = apperror.UnprocessableEntity("INVALID_PUBLISH_AT", "Publish time must be in the future").WithDetails(map[string]string{"publish_at": "Publish time must be in the future"})
+
+	ErrArticleNotPublished = apperror.Forbidden("ARTICLE_NOT_PUBLISHED", "This action is only allowed on published articles")
+	ErrInvalidReaction     = apperror.UnprocessableEntity("INVALID_REACTION", "Unsupported reaction")
+
+	ErrCommentNotFound          = apperror.NotFound("COMMENT_NOT_FOUND", "Comment not found")
+	ErrCommentForbidden         = apperror.Forbidden("COMMENT_FORBIDDEN", "You are not allowed to modify this comment")
+	ErrCommentsClosed           = apperror.Forbidden("COMMENTS_CLOSED", "Comments are only allowed on published articles")
+	ErrCommentEditWindowExpired = apperror.Forbidden("COMMENT_EDIT_WINDOW_EXPIRED", "Comment can no longer be edited")
+	ErrInvalidCommentParent     = apperror.UnprocessableEntity("INVALID_COMMENT_PARENT", "Parent comment does not exist on this article").WithDetails(map[string]string{"parent_id": "Invalid parent comment"})
+
+	ErrRevisionNotFound       = apperror.NotFound("REVISION_NOT_FOUND", "Revision not found")
+	ErrRevisionAlreadyCurrent = apperror.Conflict("REVISION_ALREADY_CURRENT", "Article content is already the same as this revision")
+
+	ErrInvalidCursor = apperror.BadRequest("INVALID_CURSOR", "Invalid pagination cursor").WithDetails(map[string]string{"cursor": "Invalid cursor"})
+)
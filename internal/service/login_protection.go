@@ -0,0 +1,131 @@
+package service
+
+import (
+	"go-article/internal/domain/model"
+	"go-article/pkg/apperror"
+	"go-article/pkg/utils"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Batas bawaan perlindungan brute force login, masing-masing bisa diganti lewat env
+const (
+	// defaultLoginBackoffBase adalah jeda setelah gagal login pertama, berlipat dua setiap gagal berikutnya
+	defaultLoginBackoffBase = time.Second
+	// defaultLoginLockoutThreshold adalah jumlah gagal berturut-turut sebelum akun dikunci
+	defaultLoginLockoutThreshold = 5
+	// defaultLoginLockoutDuration adalah lama kunci pertama, berlipat dua setiap gagal setelahnya
+	defaultLoginLockoutDuration = 15 * time.Minute
+	defaultLoginLockoutMax      = 24 * time.Hour
+	// defaultLoginIPThreshold adalah jumlah gagal login dari satu IP dalam LOGIN_IP_WINDOW sebelum IP ditahan
+	defaultLoginIPThreshold = 20
+	defaultLoginIPWindow    = 15 * time.Minute
+	// maxUserAgentLength mengikuti panjang kolom login_attempts.user_agent
+	maxUserAgentLength = 512
+)
+
+// accountLockDuration menghitung lama akun ditahan setelah gagal login ke-attempts secara berturut-turut.
+// Sebelum ambang batas jedanya pendek (1s, 2s, 4s, ...) agar salah ketik tidak mengganggu,
+// setelahnya akun dikunci LOGIN_LOCKOUT_DURATION yang berlipat dua sampai LOGIN_LOCKOUT_MAX.
+func accountLockDuration(attempts uint) time.Duration {
+	threshold := uint(utils.IntFromEnv("LOGIN_LOCKOUT_THRESHOLD", defaultLoginLockoutThreshold))
+	lockout := utils.DurationFromEnv("LOGIN_LOCKOUT_DURATION", defaultLoginLockoutDuration)
+	maxLockout := utils.DurationFromEnv("LOGIN_LOCKOUT_MAX", defaultLoginLockoutMax)
+
+	if attempts < threshold {
+		return exponentialBackoff(utils.DurationFromEnv("LOGIN_BACKOFF_BASE", defaultLoginBackoffBase), attempts-1, lockout)
+	}
+	return exponentialBackoff(lockout, attempts-threshold, maxLockout)
+}
+
+// ipBlockedUntil menentukan sampai kapan login dari satu IP ditahan berdasarkan gagal login dalam
+// LOGIN_IP_WINDOW, sehingga credential stuffing ke banyak akun dari satu IP tetap melambat
+func (a *authService) ipBlockedUntil(ipAddress string, now time.Time) *time.Time {
+	window := utils.DurationFromEnv("LOGIN_IP_WINDOW", defaultLoginIPWindow)
+	threshold := int64(utils.IntFromEnv("LOGIN_IP_THRESHOLD", defaultLoginIPThreshold))
+
+	failures, last, err := a.loginAttemptRepository.CountFailuresByIP(ipAddress, now.Add(-window))
+	if err != nil || last == nil || failures < threshold {
+		return nil
+	}
+
+	base := utils.DurationFromEnv("LOGIN_BACKOFF_BASE", defaultLoginBackoffBase)
+	until := last.Add(exponentialBackoff(base, uint(failures-threshold), window))
+	if !now.Before(until) {
+		return nil
+	}
+	return &until
+}
+
+// unknownEmailLockedUntil meniru kunci akun untuk email yang tidak terdaftar dari riwayat login_attempts.
+// Response dan Retry-After-nya sama dengan akun yang ada, sehingga tidak bisa dipakai untuk menebak email terdaftar.
+// Hanya kegagalan dalam LOGIN_LOCKOUT_MAX terakhir yang dihitung, kunci terlama tidak melebihi batas itu
+// dan email yang tidak pernah berhasil login tidak terkunci makin lama tanpa akhir.
+func (a *authService) unknownEmailLockedUntil(email string, now time.Time) *time.Time {
+	window := utils.DurationFromEnv("LOGIN_LOCKOUT_MAX", defaultLoginLockoutMax)
+
+	failures, last, err := a.loginAttemptRepository.CountUnknownEmailFailures(email, now.Add(-window))
+	if err != nil || last == nil || failures == 0 {
+		return nil
+	}
+
+	until := last.Add(accountLockDuration(uint(failures)))
+	if !now.Before(until) {
+		return nil
+	}
+	return &until
+}
+
+// dummyPasswordHash adalah hash bcrypt dengan cost yang sama seperti password user. Password dicocokkan
+// dengannya saat email tidak terdaftar agar waktu response login tidak membedakan email yang terdaftar.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, err := utils.HashPassword("go-article-dummy-password")
+	if err != nil {
+		log.Println("Error hashing dummy password:", err)
+	}
+	return hash
+})
+
+// exponentialBackoff mengembalikan base * 2^exponent dengan batas maksimum max
+func exponentialBackoff(base time.Duration, exponent uint, max time.Duration) time.Duration {
+	if exponent >= 62 {
+		return max
+	}
+	delay := float64(base) * math.Pow(2, float64(exponent))
+	if delay >= float64(max) {
+		return max
+	}
+	return time.Duration(delay)
+}
+
+// recordLoginAttempt menyimpan audit percobaan login, kegagalan menyimpan audit hanya dicatat di log
+// agar tidak menggagalkan login itu sendiri
+func (a *authService) recordLoginAttempt(userID *uint64, email string, ipAddress string, userAgent string, result string) {
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	attempt := &model.LoginAttempt{
+		UserID:    userID,
+		Email:     email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Result:    result,
+	}
+	if err := a.loginAttemptRepository.Create(attempt); err != nil {
+		log.Println("Error recording login attempt:", err)
+	}
+}
+
+// retryAfterError menambahkan sisa waktu tunggu dalam detik sebagai detail retry_after,
+// middleware.ErrorHandler menyalinnya ke header Retry-After
+func retryAfterError(err *apperror.Error, until time.Time, now time.Time) error {
+	seconds := int(math.Ceil(until.Sub(now).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return err.WithDetails(map[string]int{"retry_after": seconds})
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"go-article/internal/domain/entity"
+	"go-article/pkg/utils"
+	"go-article/pkg/webhook"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// PublishHook dipanggil setiap kali artikel menjadi published, baik lewat approve, publish manual
+// maupun scheduler. Contoh pemakaian: invalidasi cache, update feed, atau mengirim webhook.
+type PublishHook func(ctx context.Context, article entity.ArticleEntity) error
+
+// PublishNotifier menjalankan PublishHook di background agar request dan scheduler
+// tidak menunggu hook yang lambat. Wait dipanggil saat shutdown agar hook yang sedang
+// berjalan sempat selesai.
+type PublishNotifier struct {
+	hooks   []PublishHook
+	timeout time.Duration
+	wg      sync.WaitGroup
+}
+
+// NewPublishNotifier membuat PublishNotifier, timeout membatasi durasi setiap hook
+func NewPublishNotifier(timeout time.Duration, hooks ...PublishHook) *PublishNotifier {
+	return &PublishNotifier{hooks: hooks, timeout: timeout}
+}
+
+// NewPublishNotifierFromEnv membuat PublishNotifier dengan hook log dan, jika
+// PUBLISH_WEBHOOK_URL diisi, hook webhook yang ditandatangani PUBLISH_WEBHOOK_SECRET
+func NewPublishNotifierFromEnv() *PublishNotifier {
+	hooks := []PublishHook{LogPublishHook}
+	if url := os.Getenv("PUBLISH_WEBHOOK_URL"); url != "" {
+		hooks = append(hooks, WebhookPublishHook(webhook.NewClient(url, os.Getenv("PUBLISH_WEBHOOK_SECRET"))))
+	}
+	return NewPublishNotifier(utils.DurationFromEnv("PUBLISH_HOOK_TIMEOUT", 15*time.Second), hooks...)
+}
+
+// Notify menjalankan semua hook untuk artikel yang baru terbit tanpa memblokir pemanggil
+func (n *PublishNotifier) Notify(article entity.ArticleEntity) {
+	for _, hook := range n.hooks {
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			// Hook yang panic tidak boleh menjatuhkan server
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("[PublishNotifier] hook panicked for article %d: %v", article.ID, r)
+				}
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+			defer cancel()
+
+			if err := hook(ctx, article); err != nil {
+				log.Printf("[PublishNotifier] hook failed for article %d: %v", article.ID, err)
+			}
+		}()
+	}
+}
+
+// Wait menunggu semua hook yang sedang berjalan selesai atau ctx habis
+func (n *PublishNotifier) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// LogPublishHook mencatat artikel yang terbit ke log
+func LogPublishHook(ctx context.Context, article entity.ArticleEntity) error {
+	log.Printf("[PublishNotifier] article %d published: %s", article.ID, article.Slug)
+	return nil
+}
+
+// WebhookPublishHook mengirim event article.published ke webhook
+func WebhookPublishHook(client *webhook.Client) PublishHook {
+	return func(ctx context.Context, article entity.ArticleEntity) error {
+		return client.Send(ctx, "article.published", article)
+	}
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/repository"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type RoleService interface {
+	ListRoles() ([]model.Role, error)
+	GrantRole(adminID uint64, userID uint64, roleID uint64) (*entity.UserEntity, error)
+	RevokeRole(adminID uint64, userID uint64, roleID uint64) (*entity.UserEntity, error)
+}
+
+type roleService struct {
+	userRepository repository.UserRepository
+	roleRepository repository.RoleRepository
+}
+
+func NewRoleService(userRepo repository.UserRepository, roleRepo repository.RoleRepository) RoleService {
+	return &roleService{
+		userRepository: userRepo,
+		roleRepository: roleRepo,
+	}
+}
+
+// ListRoles implements RoleService.
+func (r *roleService) ListRoles() ([]model.Role, error) {
+	return r.roleRepository.FindAll()
+}
+
+// GrantRole implements RoleService.
+func (r *roleService) GrantRole(adminID uint64, userID uint64, roleID uint64) (*entity.UserEntity, error) {
+	if _, err := r.findUserAndRole(userID, roleID); err != nil {
+		return nil, err
+	}
+
+	if err := r.userRepository.GrantRole(userID, roleID, adminID); err != nil {
+		log.Println("Error granting role:", err)
+		return nil, err
+	}
+
+	return r.userRepository.FindByID(userID)
+}
+
+// RevokeRole implements RoleService.
+func (r *roleService) RevokeRole(adminID uint64, userID uint64, roleID uint64) (*entity.UserEntity, error) {
+	role, err := r.findUserAndRole(userID, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cegah admin mengunci dirinya sendiri dari panel admin
+	if adminID == userID && strings.EqualFold(role.Name, model.RoleAdmin) {
+		return nil, ErrCannotRevokeOwnAdmin
+	}
+
+	if err := r.userRepository.RevokeRole(userID, roleID); err != nil {
+		log.Println("Error revoking role:", err)
+		return nil, err
+	}
+
+	return r.userRepository.FindByID(userID)
+}
+
+// findUserAndRole memastikan user dan role yang dimaksud ada di database
+func (r *roleService) findUserAndRole(userID uint64, roleID uint64) (*model.Role, error) {
+	if _, err := r.userRepository.FindByID(userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	role, err := r.roleRepository.FindByID(roleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	return role, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/internal/search"
+	"log"
+)
+
+// rebuildBatchSize adalah jumlah artikel yang diambil per halaman saat mengisi ulang indeks
+const rebuildBatchSize = 100
+
+type SearchService interface {
+	// Search mencari artikel terbit yang relevan dengan query, dari yang paling relevan
+	Search(query request.ArticleSearchQuery) ([]entity.ArticleSearchResultEntity, int64, error)
+	// Rebuild mengisi indeks dengan semua artikel terbit dan mengembalikan jumlahnya
+	Rebuild() (int, error)
+}
+
+type searchService struct {
+	searchIndex       search.SearchIndex
+	articleRepository repository.ArticleRepository
+}
+
+func NewSearchService(searchIndex search.SearchIndex, articleRepo repository.ArticleRepository) SearchService {
+	return &searchService{
+		searchIndex:       searchIndex,
+		articleRepository: articleRepo,
+	}
+}
+
+// Search implements SearchService.
+func (s *searchService) Search(query request.ArticleSearchQuery) ([]entity.ArticleSearchResultEntity, int64, error) {
+	result, err := s.searchIndex.Search(search.Query{
+		Text:   query.Q,
+		Offset: (query.Page - 1) * query.Limit,
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uint64, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ArticleID)
+	}
+
+	articles, err := s.articleRepository.FindByIDs(ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	articlesByID := make(map[uint64]entity.ArticleEntity, len(articles))
+	for _, article := range articles {
+		articlesByID[article.ID] = article
+	}
+
+	// Urutan mengikuti skor relevansi, artikel yang sudah tidak terbit dilewati jika indeks belum sempat diperbarui
+	results := make([]entity.ArticleSearchResultEntity, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		article, ok := articlesByID[hit.ArticleID]
+		if !ok || article.Status != model.ArticleStatusPublished {
+			continue
+		}
+		results = append(results, entity.ArticleSearchResultEntity{
+			Article: article,
+			Score:   hit.Score,
+			Highlight: entity.ArticleSearchHighlight{
+				Title:   hit.Title,
+				Snippet: hit.Snippet,
+			},
+		})
+	}
+	return results, result.Total, nil
+}
+
+// Rebuild implements SearchService.
+func (s *searchService) Rebuild() (int, error) {
+	indexed := 0
+	for page := 1; ; page++ {
+		articles, total, err := s.articleRepository.FindAll(repository.ArticleFilter{
+			Status: model.ArticleStatusPublished,
+			Page:   page,
+			Limit:  rebuildBatchSize,
+		})
+		if err != nil {
+			return indexed, err
+		}
+
+		for _, article := range articles {
+			if err := s.searchIndex.Index(search.DocumentFromArticle(article)); err != nil {
+				return indexed, err
+			}
+			indexed++
+		}
+
+		if len(articles) == 0 || int64(page*rebuildBatchSize) >= total {
+			return indexed, nil
+		}
+	}
+}
+
+// syncSearchIndex menyamakan indeks pencarian dengan kondisi artikel terbaru: artikel terbit diindeks ulang,
+// selain itu dihapus dari indeks. Kegagalan hanya dicatat ke log karena perubahan artikel sudah tersimpan.
+func syncSearchIndex(searchIndex search.SearchIndex, article *entity.ArticleEntity) {
+	var err error
+	if article.Status == model.ArticleStatusPublished {
+		err = searchIndex.Index(search.DocumentFromArticle(*article))
+	} else {
+		err = searchIndex.Remove(article.ID)
+	}
+
+	if err != nil {
+		log.Printf("[SearchService] failed to sync article %d: %v", article.ID, err)
+	}
+}
+
+// removeFromSearchIndex menghapus artikel yang dihapus dari indeks pencarian
+func removeFromSearchIndex(searchIndex search.SearchIndex, articleID uint64) {
+	if err := searchIndex.Remove(articleID); err != nil {
+		log.Printf("[SearchService] failed to remove article %d: %v", articleID, err)
+	}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/auth"
+	"go-article/internal/domain/entity"
+	"go-article/internal/domain/model"
+	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSessionCacheTTL        = 10 * time.Second
+	defaultSessionCacheMaxEntries = 10000
+)
+
+// SessionService memeriksa access token terhadap data di server sehingga token bisa dicabut
+// sebelum kedaluwarsa, baik satu per satu (denylist jti) maupun seluruhnya (token_version user)
+type SessionService interface {
+	// Validate menolak token milik user yang sudah dihapus atau di-suspend, token dengan versi lama,
+	// dan token yang jti-nya ada di denylist
+	Validate(principal *auth.Principal) error
+	// RevokeToken memasukkan jti access token ke denylist sampai token tersebut kedaluwarsa
+	RevokeToken(principal *auth.Principal) error
+	// RevokeAll mengakhiri semua sesi user: refresh token dicabut dan token_version dinaikkan
+	RevokeAll(userID uint64) error
+	// PurgeExpired menghapus jti yang token-nya sudah kedaluwarsa dari denylist
+	PurgeExpired() (int64, error)
+}
+
+// sessionService menyimpan hasil pemeriksaan di memory selama SESSION_CACHE_TTL agar AuthMiddleware
+// tidak membaca database pada setiap request. Pencabutan di replika yang sama langsung berlaku,
+// replika lain paling lambat setelah TTL tersebut.
+type sessionService struct {
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	revokedTokenRepository repository.RevokedTokenRepository
+
+	users  *expiringCache[uint64, *entity.UserSessionState]
+	tokens *expiringCache[string, bool]
+}
+
+// NewSessionService adalah constructor SessionService, masa cache dari env SESSION_CACHE_TTL
+// dan jumlah entri maksimum dari env SESSION_CACHE_MAX_ENTRIES
+func NewSessionService(userRepo repository.UserRepository, refreshTokenRepo repository.RefreshTokenRepository, revokedTokenRepo repository.RevokedTokenRepository) SessionService {
+	ttl := utils.DurationFromEnv("SESSION_CACHE_TTL", defaultSessionCacheTTL)
+	maxEntries := utils.IntFromEnv("SESSION_CACHE_MAX_ENTRIES", defaultSessionCacheMaxEntries)
+	return &sessionService{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		revokedTokenRepository: revokedTokenRepo,
+		users:                  newExpiringCache[uint64, *entity.UserSessionState](ttl, maxEntries),
+		tokens:                 newExpiringCache[string, bool](ttl, maxEntries),
+	}
+}
+
+// Validate implements SessionService.
+func (s *sessionService) Validate(principal *auth.Principal) error {
+	state, err := s.sessionState(principal.UserID)
+	if err != nil {
+		return err
+	}
+	// User yang sudah dihapus disimpan sebagai nil agar request berikutnya juga tidak membaca database
+	if state == nil {
+		return ErrTokenRevoked
+	}
+	if state.Status == model.UserStatusSuspended {
+		return ErrAccountSuspended
+	}
+	if principal.TokenVersion != state.TokenVersion {
+		return ErrTokenRevoked
+	}
+
+	revoked, ok := s.tokens.get(principal.TokenID)
+	if !ok {
+		generation := s.tokens.generation()
+		revoked, err = s.revokedTokenRepository.IsRevoked(principal.TokenID)
+		if err != nil {
+			return err
+		}
+		s.tokens.fill(principal.TokenID, revoked, generation)
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// RevokeToken implements SessionService.
+func (s *sessionService) RevokeToken(principal *auth.Principal) error {
+	// Token masih diterima selama leeway setelah exp, simpan sampai lewat batas itu
+	expiresAt := principal.ExpiresAt.Add(utils.TokenLeeway())
+	if principal.TokenID == "" || time.Now().After(expiresAt) {
+		return nil
+	}
+
+	err := s.revokedTokenRepository.Create(&model.RevokedToken{
+		JTI:       principal.TokenID,
+		UserID:    principal.UserID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	s.tokens.set(principal.TokenID, true)
+	return nil
+}
+
+// RevokeAll implements SessionService.
+func (s *sessionService) RevokeAll(userID uint64) error {
+	if err := s.refreshTokenRepository.RevokeAllByUser(userID); err != nil {
+		return err
+	}
+	if err := s.userRepository.IncrementTokenVersion(userID); err != nil {
+		return err
+	}
+	s.users.delete(userID)
+	return nil
+}
+
+// PurgeExpired implements SessionService.
+func (s *sessionService) PurgeExpired() (int64, error) {
+	return s.revokedTokenRepository.DeleteExpired(time.Now())
+}
+
+// sessionState membaca status dan versi token user dari cache atau database, nil jika user tidak ada
+func (s *sessionService) sessionState(userID uint64) (*entity.UserSessionState, error) {
+	if state, ok := s.users.get(userID); ok {
+		return state, nil
+	}
+
+	// Generation dibaca sebelum query agar hasil yang dibaca sebelum RevokeAll tidak masuk ke cache
+	generation := s.users.generation()
+	state, err := s.userRepository.FindSessionState(userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		state = nil
+	}
+	s.users.fill(userID, state, generation)
+	return state, nil
+}
+
+// expiringCache adalah map dengan masa berlaku per entri. Jika penuh, entri kedaluwarsa dibuang lebih dulu
+// dan jika masih penuh seluruh isi dikosongkan, cukup untuk cache pendek yang bisa dibaca ulang dari database.
+//
+// Hasil baca database disimpan dengan fill, bukan set. Pembacaan bisa kalah cepat dengan pencabutan yang
+// berjalan bersamaan, sehingga fill diabaikan jika set atau delete terjadi sejak generation dibaca.
+// Generation berlaku untuk seluruh cache agar tidak perlu disimpan per key; pencabutan jarang terjadi,
+// jadi fill yang ikut terlewat hanya membuat request berikutnya membaca database lagi.
+type expiringCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[K]expiringEntry[V]
+	// changes naik setiap kali set atau delete dipanggil
+	changes uint64
+}
+
+type expiringEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func newExpiringCache[K comparable, V any](ttl time.Duration, maxEntries int) *expiringCache[K, V] {
+	return &expiringCache[K, V]{ttl: ttl, maxEntries: maxEntries, entries: make(map[K]expiringEntry[V])}
+}
+
+func (c *expiringCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// generation dibaca sebelum mengambil data dari database yang hasilnya akan disimpan dengan fill
+func (c *expiringCache[K, V]) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.changes
+}
+
+// fill menyimpan hasil baca database, kecuali cache sudah berubah sejak generation dibaca
+func (c *expiringCache[K, V]) fill(key K, value V, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.changes != generation {
+		return
+	}
+	c.store(key, value)
+}
+
+// set menyimpan nilai terbaru yang ditulis sendiri oleh service, misalnya jti yang baru dicabut
+func (c *expiringCache[K, V]) set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.changes++
+	c.store(key, value)
+}
+
+// store menyimpan entri, pemanggil harus memegang lock
+func (c *expiringCache[K, V]) store(key K, value V) {
+	now := time.Now()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			log.Printf("[SessionService] cache full with %d entries, clearing", len(c.entries))
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = expiringEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *expiringCache[K, V]) delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.changes++
+	delete(c.entries, key)
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"errors"
+	"go-article/internal/domain/entity"
+	"go-article/internal/handler/request"
+	"go-article/internal/repository"
+	"go-article/internal/search"
+	"go-article/pkg/utils"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type TagService interface {
+	List(query request.TagListQuery) ([]entity.TagEntity, int64, error)
+	Autocomplete(query request.TagAutocompleteQuery) ([]entity.TagEntity, error)
+	Rename(tagID uint64, request request.RenameTagRequest) (*entity.TagEntity, error)
+	Merge(sourceID uint64, targetID uint64) (*entity.TagEntity, error)
+	Delete(tagID uint64) error
+}
+
+type tagService struct {
+	tagRepository     repository.TagRepository
+	articleRepository repository.ArticleRepository
+	searchIndex       search.SearchIndex
+}
+
+func NewTagService(tagRepo repository.TagRepository, articleRepo repository.ArticleRepository, searchIndex search.SearchIndex) TagService {
+	return &tagService{
+		tagRepository:     tagRepo,
+		articleRepository: articleRepo,
+		searchIndex:       searchIndex,
+	}
+}
+
+// List implements TagService.
+func (s *tagService) List(query request.TagListQuery) ([]entity.TagEntity, int64, error) {
+	filter := repository.TagFilter{
+		Search: strings.TrimSpace(query.Search),
+		Page:   query.Page,
+		Limit:  query.Limit,
+	}
+	return s.tagRepository.FindAll(filter)
+}
+
+// Autocomplete implements TagService.
+func (s *tagService) Autocomplete(query request.TagAutocompleteQuery) ([]entity.TagEntity, error) {
+	filter := repository.TagFilter{
+		Search: strings.TrimSpace(query.Query),
+		Page:   1,
+		Limit:  query.Limit,
+	}
+
+	tags, _, err := s.tagRepository.FindAll(filter)
+	return tags, err
+}
+
+// Rename implements TagService.
+func (s *tagService) Rename(tagID uint64, request request.RenameTagRequest) (*entity.TagEntity, error) {
+	tag, err := s.findTag(tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := normalizeTagName(request.Name)
+	slug := utils.Slugify(name)
+	if slug == "" {
+		return nil, ErrInvalidTagName
+	}
+
+	// Slug yang sudah dipakai tag lain harus digabung lewat Merge, bukan Rename
+	if slug != tag.Slug {
+		existing, err := s.tagRepository.FindBySlug(slug)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, ErrTagSlugTaken.WithDetails(map[string]interface{}{"name": "Tag already exists, merge it instead", "existing_tag_id": existing.ID})
+		}
+	}
+
+	renamed, err := s.tagRepository.Rename(tag.ID, name, slug)
+	if err != nil {
+		log.Println("Error renaming tag:", err)
+		return nil, err
+	}
+
+	s.reindex(s.publishedArticleIDs(tag.ID))
+	return renamed, nil
+}
+
+// Merge implements TagService.
+func (s *tagService) Merge(sourceID uint64, targetID uint64) (*entity.TagEntity, error) {
+	if sourceID == targetID {
+		return nil, ErrCannotMergeTagIntoItself
+	}
+
+	if _, err := s.findTag(sourceID); err != nil {
+		return nil, err
+	}
+	if _, err := s.findTag(targetID); err != nil {
+		return nil, err
+	}
+
+	// Setelah merge artikel tag sumber memakai tag tujuan, ID-nya harus dicari sebelum relasinya dipindah
+	articleIDs := s.publishedArticleIDs(sourceID)
+	merged, err := s.tagRepository.Merge(sourceID, targetID)
+	if err != nil {
+		log.Println("Error merging tags:", err)
+		return nil, err
+	}
+
+	s.reindex(articleIDs)
+	return merged, nil
+}
+
+// Delete implements TagService.
+func (s *tagService) Delete(tagID uint64) error {
+	if _, err := s.findTag(tagID); err != nil {
+		return err
+	}
+
+	articleIDs := s.publishedArticleIDs(tagID)
+	if err := s.tagRepository.Delete(tagID); err != nil {
+		return err
+	}
+
+	s.reindex(articleIDs)
+	return nil
+}
+
+// publishedArticleIDs mengambil artikel terbit yang memakai tag. Kegagalan hanya dicatat di log karena
+// perubahan tag tetap berlaku, indeks akan diperbarui saat artikel berubah atau aplikasi start ulang.
+func (s *tagService) publishedArticleIDs(tagID uint64) []uint64 {
+	ids, err := s.tagRepository.FindPublishedArticleIDs(tagID)
+	if err != nil {
+		log.Printf("[SearchService] failed to find articles of tag %d: %v", tagID, err)
+		return nil
+	}
+	return ids
+}
+
+// reindex mengindeks ulang artikel per batch agar indeks pencarian memakai nama tag terbaru
+func (s *tagService) reindex(articleIDs []uint64) {
+	for start := 0; start < len(articleIDs); start += rebuildBatchSize {
+		batch := articleIDs[start:min(start+rebuildBatchSize, len(articleIDs))]
+		articles, err := s.articleRepository.FindByIDs(batch)
+		if err != nil {
+			log.Printf("[SearchService] failed to reindex %d articles: %v", len(batch), err)
+			continue
+		}
+		for i := range articles {
+			syncSearchIndex(s.searchIndex, &articles[i])
+		}
+	}
+}
+
+// findTag mengambil tag dan menerjemahkan record not found menjadi ErrTagNotFound
+func (s *tagService) findTag(tagID uint64) (*entity.TagEntity, error) {
+	tag, err := s.tagRepository.FindByID(tagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTagNotFound
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// normalizeTagName merapikan spasi pada nama tag, misalnya "  Web   Dev " menjadi "Web Dev"
+func normalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// buildTags mengubah daftar nama tag dari request menjadi TagEntity unik berdasarkan slug
+func buildTags(names []string) []entity.TagEntity {
+	tags := make([]entity.TagEntity, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = normalizeTagName(name)
+		slug := utils.Slugify(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		tags = append(tags, entity.TagEntity{Name: name, Slug: slug})
+	}
+	return tags
+}
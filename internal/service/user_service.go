@@ -3,31 +3,112 @@ package service
 import (
 	"errors"
 	"go-article/internal/domain/entity"
+	"go-article/internal/handler/request"
 	"go-article/internal/repository"
+	"go-article/pkg/utils"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
 )
 
 type UserService interface {
 	GetUserByID(userID uint64) (*entity.UserEntity, error)
+	UpdateProfile(userID uint64, request request.UpdateProfileRequest) (*entity.UserEntity, error)
+	ChangePassword(userID uint64, request request.ChangePasswordRequest) error
+	DeleteAccount(userID uint64) error
 }
 
 type userService struct {
-	userRepo repository.UserRepository
+	userRepo       repository.UserRepository
+	sessionService SessionService
 }
 
 // GetUserByID implements UserService.
 func (u *userService) GetUserByID(userID uint64) (*entity.UserEntity, error) {
 	user, err := u.userRepo.FindByID(userID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return user, ErrUserNotFound
+		}
 		return user, err
 	}
 	if user.ID == 0 {
-		return user, errors.New("user not found")
+		return user, ErrUserNotFound
 	}
 	return user, nil
 }
 
-func NewUserService(userRepo repository.UserRepository) UserService {
+// UpdateProfile implements UserService.
+func (u *userService) UpdateProfile(userID uint64, request request.UpdateProfileRequest) (*entity.UserEntity, error) {
+	user, err := u.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hanya field yang dikirim yang diubah
+	name := user.Name
+	if request.Name != nil {
+		name = strings.TrimSpace(*request.Name)
+	}
+
+	avatar := user.Avatar
+	if request.Avatar != nil {
+		avatar = request.Avatar
+		// String kosong berarti avatar dihapus
+		if *request.Avatar == "" {
+			avatar = nil
+		}
+	}
+
+	if err := u.userRepo.UpdateProfile(userID, name, avatar); err != nil {
+		return nil, err
+	}
+
+	return u.GetUserByID(userID)
+}
+
+// ChangePassword implements UserService.
+func (u *userService) ChangePassword(userID uint64, request request.ChangePasswordRequest) error {
+	user, err := u.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if !utils.CheckPasswordHash(request.CurrentPassword, user.Password) {
+		return ErrIncorrectPassword
+	}
+
+	hashedPassword, err := utils.HashPassword(request.NewPassword)
+	if err != nil {
+		log.Println("Error hashing password:", err)
+		return err
+	}
+
+	if err := u.userRepo.UpdatePassword(userID, hashedPassword); err != nil {
+		return err
+	}
+
+	// Semua sesi, termasuk access token yang sudah terbit, harus login ulang dengan password baru
+	return u.sessionService.RevokeAll(userID)
+}
+
+// DeleteAccount implements UserService.
+func (u *userService) DeleteAccount(userID uint64) error {
+	if _, err := u.GetUserByID(userID); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.SoftDelete(userID); err != nil {
+		return err
+	}
+
+	return u.sessionService.RevokeAll(userID)
+}
+
+func NewUserService(userRepo repository.UserRepository, sessionService SessionService) UserService {
 	return &userService{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		sessionService: sessionService,
 	}
 }
@@ -0,0 +1,111 @@
+package apperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Error adalah error aplikasi yang membawa status HTTP, kode yang bisa dibaca mesin,
+// pesan untuk user dan detail per field. Error ini diterjemahkan menjadi utils.Response
+// oleh middleware.ErrorHandler sehingga handler tidak perlu memetakan error satu per satu.
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	Details interface{}
+	// Err adalah penyebab asli, hanya untuk log dan tidak pernah dikirim ke client
+	Err error
+}
+
+// New membuat Error baru
+func New(status int, code string, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// Error mengimplementasikan interface error
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap mengembalikan penyebab asli agar errors.Is/As tetap bekerja
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is membuat dua Error dianggap sama jika kodenya sama, sehingga salinan hasil
+// WithDetails/Wrap tetap cocok dengan errors.Is terhadap error sentinel-nya
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithDetails mengembalikan salinan error dengan detail tambahan
+func (e *Error) WithDetails(details interface{}) *Error {
+	clone := *e
+	clone.Details = details
+	return &clone
+}
+
+// Wrap mengembalikan salinan error dengan penyebab asli
+func (e *Error) Wrap(err error) *Error {
+	clone := *e
+	clone.Err = err
+	return &clone
+}
+
+// BadRequest membuat error 400
+func BadRequest(code string, message string) *Error {
+	return New(http.StatusBadRequest, code, message)
+}
+
+// Unauthorized membuat error 401
+func Unauthorized(code string, message string) *Error {
+	return New(http.StatusUnauthorized, code, message)
+}
+
+// Forbidden membuat error 403
+func Forbidden(code string, message string) *Error {
+	return New(http.StatusForbidden, code, message)
+}
+
+// NotFound membuat error 404
+func NotFound(code string, message string) *Error {
+	return New(http.StatusNotFound, code, message)
+}
+
+// Conflict membuat error 409
+func Conflict(code string, message string) *Error {
+	return New(http.StatusConflict, code, message)
+}
+
+// UnprocessableEntity membuat error 422
+func UnprocessableEntity(code string, message string) *Error {
+	return New(http.StatusUnprocessableEntity, code, message)
+}
+
+// TooManyRequests membuat error 429
+func TooManyRequests(code string, message string) *Error {
+	return New(http.StatusTooManyRequests, code, message)
+}
+
+// Validation membuat error 400 untuk input yang tidak lolos validasi
+func Validation(details interface{}) *Error {
+	return BadRequest("VALIDATION_FAILED", "Validation failed").WithDetails(details)
+}
+
+// Internal membungkus error yang tidak dikenal menjadi error 500 tanpa membocorkan detailnya
+func Internal(err error) *Error {
+	return New(http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error").Wrap(err)
+}
+
+// From mengubah error apa pun menjadi *Error, error yang tidak dikenal menjadi Internal
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return Internal(err)
+}
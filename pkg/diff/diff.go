@@ -0,0 +1,138 @@
+// Package diff membandingkan dua teks baris per baris dan menghasilkan unified diff
+package diff
+
+import "strings"
+
+// Op adalah jenis perubahan sebuah baris
+type Op int
+
+const (
+	// Equal berarti baris ada di kedua teks
+	Equal Op = iota
+	// Delete berarti baris hanya ada di teks lama
+	Delete
+	// Insert berarti baris hanya ada di teks baru
+	Insert
+)
+
+// Edit adalah satu baris hasil perbandingan
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Lines membandingkan dua teks baris per baris memakai algoritma Myers,
+// sehingga hasilnya adalah urutan edit terpendek dari a ke b
+func Lines(a, b string) []Edit {
+	return compare(splitLines(a), splitLines(b))
+}
+
+// compare menghitung edit antara dua slice baris.
+// Awalan dan akhiran yang sama dilewati lebih dulu agar pencarian Myers hanya berjalan di bagian yang berubah.
+func compare(a, b []string) []Edit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		edits = append(edits, Edit{Op: Equal, Text: line})
+	}
+	edits = append(edits, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		edits = append(edits, Edit{Op: Equal, Text: line})
+	}
+	return edits
+}
+
+// myers mencari jalur edit terpendek lalu menelusurinya mundur untuk menyusun daftar edit
+func myers(a, b []string) []Edit {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+
+	// trace menyimpan salinan v di awal setiap langkah d untuk penelusuran mundur
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, a, b, offset)
+			}
+		}
+	}
+	return nil
+}
+
+// backtrack menelusuri trace dari titik akhir ke awal dan mengembalikan edit sesuai urutan teks
+func backtrack(trace [][]int, a, b []string, offset int) []Edit {
+	x, y := len(a), len(b)
+	var reversed []Edit
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, Edit{Op: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, Edit{Op: Insert, Text: b[y-1]})
+			} else {
+				reversed = append(reversed, Edit{Op: Delete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	edits := make([]Edit, len(reversed))
+	for i, edit := range reversed {
+		edits[len(reversed)-1-i] = edit
+	}
+	return edits
+}
+
+// splitLines memecah teks menjadi baris, CRLF dianggap sama dengan LF
+// dan newline di akhir teks tidak menghasilkan baris kosong tambahan
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
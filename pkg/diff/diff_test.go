@@ -0,0 +1,185 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// apply membentuk ulang teks lama dan baru dari daftar edit
+func apply(edits []Edit) (from []string, to []string) {
+	for _, edit := range edits {
+		if edit.Op != Insert {
+			from = append(from, edit.Text)
+		}
+		if edit.Op != Delete {
+			to = append(to, edit.Text)
+		}
+	}
+	return from, to
+}
+
+func countChanges(edits []Edit) int {
+	n := 0
+	for _, edit := range edits {
+		if edit.Op != Equal {
+			n++
+		}
+	}
+	return n
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        string
+		wantChanges int
+		want        []Edit
+	}{
+		{
+			name: "identical",
+			a:    "a\nb\n", b: "a\nb\n",
+			want: []Edit{{Equal, "a"}, {Equal, "b"}},
+		},
+		{
+			name: "both empty",
+			a:    "", b: "",
+			want: []Edit{},
+		},
+		{
+			name: "from empty",
+			a:    "", b: "a\nb",
+			wantChanges: 2,
+			want:        []Edit{{Insert, "a"}, {Insert, "b"}},
+		},
+		{
+			name: "to empty",
+			a:    "a\nb", b: "",
+			wantChanges: 2,
+			want:        []Edit{{Delete, "a"}, {Delete, "b"}},
+		},
+		{
+			name: "replace middle line",
+			a:    "a\nb\nc", b: "a\nx\nc",
+			wantChanges: 2,
+			want:        []Edit{{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"}},
+		},
+		{
+			name: "crlf equals lf",
+			a:    "a\r\nb\r\n", b: "a\nb",
+			want: []Edit{{Equal, "a"}, {Equal, "b"}},
+		},
+		{
+			// Contoh dari makalah Myers, jarak edit terpendeknya adalah 5
+			name: "myers paper example",
+			a:    "A\nB\nC\nA\nB\nB\nA", b: "C\nB\nA\nB\nA\nC",
+			wantChanges: 5,
+		},
+		{
+			name: "reordered lines",
+			a:    "1\n2\n3\n4\n5", b: "5\n1\n2\n3\n4",
+			wantChanges: 2,
+			want:        []Edit{{Insert, "5"}, {Equal, "1"}, {Equal, "2"}, {Equal, "3"}, {Equal, "4"}, {Delete, "5"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edits := Lines(tt.a, tt.b)
+
+			from, to := apply(edits)
+			if !reflect.DeepEqual(from, splitLines(tt.a)) || !reflect.DeepEqual(to, splitLines(tt.b)) {
+				t.Fatalf("edits %v do not rebuild the input texts", edits)
+			}
+			if got := countChanges(edits); got != tt.wantChanges {
+				t.Errorf("changes = %d, want %d: %v", got, tt.wantChanges, edits)
+			}
+			if tt.want != nil && !reflect.DeepEqual(edits, tt.want) {
+				t.Errorf("Lines() = %v, want %v", edits, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnified(t *testing.T) {
+	// numbers membuat teks berisi baris "aa", "bb", ... dengan baris ke-i diganti replace[i]
+	numbers := func(from, to int, replace map[int]string) string {
+		var lines []string
+		for i := from; i <= to; i++ {
+			line, ok := replace[i]
+			if !ok {
+				line = strings.Repeat(string(rune('a'+i-1)), 2)
+			}
+			lines = append(lines, line)
+		}
+		return strings.Join(lines, "\n") + "\n"
+	}
+
+	tests := []struct {
+		name    string
+		a, b    string
+		context int
+		want    string
+	}{
+		{
+			name: "no changes",
+			a:    "a\nb\n", b: "a\nb\n",
+			context: DefaultContext,
+			want:    "",
+		},
+		{
+			name: "single change with context",
+			a:    numbers(1, 10, nil), b: numbers(1, 10, map[int]string{5: "XX"}),
+			context: DefaultContext,
+			want: "--- v1\n+++ v2\n" +
+				"@@ -2,7 +2,7 @@\n bb\n cc\n dd\n-ee\n+XX\n ff\n gg\n hh\n",
+		},
+		{
+			name: "nearby changes share a hunk",
+			a:    numbers(1, 10, nil), b: numbers(1, 10, map[int]string{2: "XX", 8: "YY"}),
+			context: DefaultContext,
+			want: "--- v1\n+++ v2\n" +
+				"@@ -1,10 +1,10 @@\n aa\n-bb\n+XX\n cc\n dd\n ee\n ff\n gg\n-hh\n+YY\n ii\n jj\n",
+		},
+		{
+			name: "distant changes get separate hunks",
+			a:    numbers(1, 10, nil), b: numbers(1, 10, map[int]string{1: "XX", 10: "YY"}),
+			context: 1,
+			want: "--- v1\n+++ v2\n" +
+				"@@ -1,2 +1,2 @@\n-aa\n+XX\n bb\n" +
+				"@@ -9,2 +9,2 @@\n ii\n-jj\n+YY\n",
+		},
+		{
+			name: "pure insertion without context",
+			a:    "aa\nbb\n", b: "aa\nnew\nbb\n",
+			context: 0,
+			want:    "--- v1\n+++ v2\n@@ -1,0 +2 @@\n+new\n",
+		},
+		{
+			name: "pure deletion without context",
+			a:    "aa\nbb\ncc\n", b: "aa\ncc\n",
+			context: 0,
+			want:    "--- v1\n+++ v2\n@@ -2 +1,0 @@\n-bb\n",
+		},
+		{
+			name: "new file",
+			a:    "", b: "aa\nbb\n",
+			context: DefaultContext,
+			want:    "--- v1\n+++ v2\n@@ -0,0 +1,2 @@\n+aa\n+bb\n",
+		},
+		{
+			name: "negative context treated as zero",
+			a:    "aa\nbb\ncc\n", b: "aa\nXX\ncc\n",
+			context: -1,
+			want:    "--- v1\n+++ v2\n@@ -2 +2 @@\n-bb\n+XX\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("v1", "v2", tt.a, tt.b, tt.context); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultContext adalah jumlah baris konteks di sekitar perubahan, sama seperti `diff -u`
+const DefaultContext = 3
+
+// Unified membandingkan dua teks dan mengembalikan unified diff dengan header fromName/toName.
+// Hasilnya string kosong jika kedua teks sama.
+func Unified(fromName, toName, a, b string, context int) string {
+	edits := Lines(a, b)
+	hunks := buildHunks(edits, context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+	for _, h := range hunks {
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(h.fromLine, h.fromCount), hunkRange(h.toLine, h.toCount))
+		for _, edit := range edits[h.start:h.end] {
+			switch edit.Op {
+			case Equal:
+				sb.WriteByte(' ')
+			case Delete:
+				sb.WriteByte('-')
+			case Insert:
+				sb.WriteByte('+')
+			}
+			sb.WriteString(edit.Text)
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// hunk adalah rentang edit [start, end) beserta posisi baris awalnya (0-based) di kedua teks
+type hunk struct {
+	start, end          int
+	fromLine, fromCount int
+	toLine, toCount     int
+}
+
+// buildHunks mengelompokkan perubahan yang jaraknya tidak lebih dari 2*context baris ke dalam satu hunk
+func buildHunks(edits []Edit, context int) []hunk {
+	if context < 0 {
+		context = 0
+	}
+
+	// Posisi baris di teks lama dan baru sebelum setiap edit
+	fromAt := make([]int, len(edits)+1)
+	toAt := make([]int, len(edits)+1)
+	for i, edit := range edits {
+		fromAt[i+1], toAt[i+1] = fromAt[i], toAt[i]
+		if edit.Op != Insert {
+			fromAt[i+1]++
+		}
+		if edit.Op != Delete {
+			toAt[i+1]++
+		}
+	}
+
+	var hunks []hunk
+	for i := 0; i < len(edits); {
+		if edits[i].Op == Equal {
+			i++
+			continue
+		}
+
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+
+		// Perluas hunk selama perubahan berikutnya masih berdekatan
+		lastChange := i
+		for j := i + 1; j < len(edits) && j-lastChange <= 2*context+1; j++ {
+			if edits[j].Op != Equal {
+				lastChange = j
+			}
+		}
+
+		end := lastChange + 1 + context
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		hunks = append(hunks, hunk{
+			start:     start,
+			end:       end,
+			fromLine:  fromAt[start],
+			fromCount: fromAt[end] - fromAt[start],
+			toLine:    toAt[start],
+			toCount:   toAt[end] - toAt[start],
+		})
+		i = end
+	}
+	return hunks
+}
+
+// hunkRange menulis rentang baris dengan format "awal,jumlah" yang 1-based,
+// rentang kosong menunjuk baris sebelum posisi sisipan seperti GNU diff
+func hunkRange(line, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", line+1)
+	}
+	return fmt.Sprintf("%d,%d", line+1, count)
+}
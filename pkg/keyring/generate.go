@@ -0,0 +1,118 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultRSABits adalah ukuran kunci RSA bawaan untuk Generate
+const DefaultRSABits = 3072
+
+// NewKeyID membuat kid yang diawali waktu pembuatan (UTC) sehingga urutan kid sama dengan urutan pembuatan
+func NewKeyID(now time.Time) (string, error) {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	now = now.UTC()
+	return fmt.Sprintf("%s%03dZ-%s", now.Format("20060102T150405"), now.Nanosecond()/int(time.Millisecond), hex.EncodeToString(suffix)), nil
+}
+
+// Generate membuat kunci privat baru dengan algoritma RS256 atau EdDSA dan menyimpannya
+// sebagai <kid>.pem (PKCS#8, permission 0600) di dir
+func Generate(dir string, algorithm string, rsaBits int) (*Key, error) {
+	var private interface{}
+	var public interface{}
+	switch algorithm {
+	case AlgorithmEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		private, public = priv, pub
+	case AlgorithmRS256:
+		if rsaBits < 2048 {
+			return nil, errors.New("keyring: RSA key must be at least 2048 bits")
+		}
+		priv, err := rsa.GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return nil, err
+		}
+		private, public = priv, &priv.PublicKey
+	default:
+		return nil, fmt.Errorf("keyring: unsupported algorithm %q, use %s or %s", algorithm, AlgorithmEdDSA, AlgorithmRS256)
+	}
+
+	kid, err := NewKeyID(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	if err := writePEM(keyPath(dir, kid), "PRIVATE KEY", der, 0o600); err != nil {
+		return nil, err
+	}
+	return &Key{ID: kid, Algorithm: algorithm, private: private, public: public}, nil
+}
+
+// Demote mengganti file kunci privat dengan kunci publiknya, sehingga kunci tersebut
+// tidak bisa lagi menandatangani tetapi token yang sudah terbit tetap bisa diverifikasi
+func Demote(dir string, key *Key) error {
+	if !key.CanSign() {
+		return nil
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.public)
+	if err != nil {
+		return err
+	}
+	return writePEM(keyPath(dir, key.ID), "PUBLIC KEY", der, 0o644)
+}
+
+// Remove menghapus file kunci dari dir
+func Remove(dir string, kid string) error {
+	if !kidPattern.MatchString(kid) {
+		return fmt.Errorf("keyring: invalid key id %q", kid)
+	}
+	return os.Remove(keyPath(dir, kid))
+}
+
+func keyPath(dir string, kid string) string {
+	return filepath.Join(dir, kid+".pem")
+}
+
+// writePEM menulis ke file sementara lalu me-rename agar replika yang sedang reload tidak membaca file setengah jadi
+func writePEM(path string, blockType string, der []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".key-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := pem.Encode(tmp, &pem.Block{Type: blockType, Bytes: der}); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,318 @@
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmHS256 = "HS256"
+)
+
+// reloadInterval adalah jeda minimum antar reload direktori ketika token membawa kid yang belum dikenal
+const reloadInterval = 30 * time.Second
+
+var (
+	// ErrNoSigningKey dikembalikan jika keyring tidak punya kunci privat untuk menandatangani token
+	ErrNoSigningKey = errors.New("keyring: no signing key")
+	// ErrUnknownKey dikembalikan jika kid pada token tidak ada di keyring atau algoritmanya tidak cocok
+	ErrUnknownKey = errors.New("keyring: unknown key")
+
+	kidPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+)
+
+// Key adalah satu kunci JWT. Kunci tanpa bagian privat hanya dipakai untuk verifikasi,
+// misalnya kunci lama yang masih harus menerima token yang belum kedaluwarsa setelah rotasi.
+type Key struct {
+	ID        string
+	Algorithm string
+	private   interface{}
+	public    interface{}
+}
+
+// CanSign menandakan kunci punya bagian privat
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+// Public mengembalikan kunci publik (*rsa.PublicKey atau ed25519.PublicKey), nil untuk HS256
+func (k *Key) Public() crypto.PublicKey {
+	if k.Algorithm == AlgorithmHS256 {
+		return nil
+	}
+	return k.public
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// Keyring menyimpan kunci penandatangan dan kunci verifikasi JWT yang diidentifikasi dengan kid.
+// Keyring yang dimuat dari direktori membaca ulang isinya ketika menemukan kid baru, sehingga replika
+// yang belum di-restart tetap menerima token dari replika yang sudah memakai kunci hasil rotasi.
+type Keyring struct {
+	dir          string
+	signingKID   string
+	legacySecret []byte
+
+	mu         sync.RWMutex
+	keys       map[string]*Key
+	signing    *Key
+	lastReload time.Time
+}
+
+// NewFromEnv membuat Keyring dari env. Jika JWT_KEYS_DIR diisi, kunci RS256/EdDSA dibaca dari file PEM
+// di direktori tersebut dan JWT_SIGNING_KEY memilih kid penandatangan (kosong berarti kunci privat terbaru).
+// Tanpa JWT_KEYS_DIR token ditandatangani HS256 dengan JWT_SECRET seperti sebelumnya.
+// JWT_ACCEPT_HS256=true tetap menerima token HS256 lama selama masa migrasi ke kunci asimetris.
+func NewFromEnv() (*Keyring, error) {
+	secret := os.Getenv("JWT_SECRET")
+	dir := os.Getenv("JWT_KEYS_DIR")
+	if dir == "" {
+		if secret == "" {
+			return nil, errors.New("JWT_KEYS_DIR or JWT_SECRET environment variable is not set")
+		}
+		return NewHMAC(secret), nil
+	}
+
+	var legacySecret string
+	if accept, _ := strconv.ParseBool(os.Getenv("JWT_ACCEPT_HS256")); accept {
+		legacySecret = secret
+	}
+	return LoadDir(dir, os.Getenv("JWT_SIGNING_KEY"), legacySecret)
+}
+
+// NewHMAC membuat Keyring HS256 dengan satu secret tanpa kid
+func NewHMAC(secret string) *Keyring {
+	key := hmacKey(secret)
+	return &Keyring{keys: map[string]*Key{key.ID: key}, signing: key}
+}
+
+// LoadDir memuat semua file <kid>.pem dari dir. File berisi kunci privat (PKCS#8, atau PKCS#1 untuk RSA)
+// bisa menandatangani, file berisi PUBLIC KEY hanya untuk verifikasi. signingKID kosong berarti kunci
+// privat dengan kid terbesar, kid buatan perintah "keys" diawali waktu pembuatan sehingga yang terbaru terpilih.
+// legacySecret yang tidak kosong membuat token HS256 tanpa kid tetap diterima.
+func LoadDir(dir string, signingKID string, legacySecret string) (*Keyring, error) {
+	k := &Keyring{dir: dir, signingKID: signingKID}
+	if legacySecret != "" {
+		k.legacySecret = []byte(legacySecret)
+	}
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Reload membaca ulang direktori kunci, tidak berpengaruh pada keyring HS256
+func (k *Keyring) Reload() error {
+	if k.dir == "" {
+		return nil
+	}
+
+	keys, err := ReadDir(k.dir)
+	if err != nil {
+		return err
+	}
+
+	index := make(map[string]*Key, len(keys)+1)
+	var signing *Key
+	for _, key := range keys {
+		index[key.ID] = key
+		if !key.CanSign() {
+			continue
+		}
+		if k.signingKID == "" && (signing == nil || key.ID > signing.ID) || key.ID == k.signingKID {
+			signing = key
+		}
+	}
+	if signing == nil {
+		if k.signingKID != "" {
+			return fmt.Errorf("keyring: signing key %q not found in %s", k.signingKID, k.dir)
+		}
+		return fmt.Errorf("keyring: no private key found in %s", k.dir)
+	}
+	if k.legacySecret != nil {
+		legacy := hmacKey(string(k.legacySecret))
+		legacy.private = nil
+		index[legacy.ID] = legacy
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = index
+	k.signing = signing
+	k.lastReload = time.Now()
+	return nil
+}
+
+// Sign menandatangani claims dengan kunci penandatangan aktif dan mengisi header kid
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	k.mu.RLock()
+	signing := k.signing
+	k.mu.RUnlock()
+	if signing == nil || !signing.CanSign() {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(signing.method(), claims)
+	if signing.ID != "" {
+		token.Header["kid"] = signing.ID
+	}
+	return token.SignedString(signing.private)
+}
+
+// Keyfunc dipakai jwt.Parse untuk memilih kunci verifikasi berdasarkan kid. Algoritma token harus sama
+// dengan algoritma kunci sehingga token tidak bisa memaksa kunci publik RSA dipakai sebagai secret HMAC.
+func (k *Keyring) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key := k.lookup(kid)
+	if key == nil && kid != "" && k.claimReload() {
+		if err := k.Reload(); err == nil {
+			key = k.lookup(kid)
+		}
+	}
+	if key == nil || token.Method.Alg() != key.Algorithm {
+		return nil, ErrUnknownKey
+	}
+	return key.public, nil
+}
+
+// Algorithms mengembalikan semua algoritma yang didukung keyring, untuk jwt.WithValidMethods.
+// Daftarnya tetap (tidak bergantung pada isi keyring) agar token dari kunci hasil rotasi dengan
+// algoritma berbeda tetap sampai ke Keyfunc, yang memastikan algoritma token sama dengan kuncinya.
+func Algorithms() []string {
+	return []string{AlgorithmRS256, AlgorithmEdDSA, AlgorithmHS256}
+}
+
+// Keys mengembalikan semua kunci diurutkan berdasarkan kid
+func (k *Keyring) Keys() []*Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := make([]*Key, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
+
+// SigningKeyID mengembalikan kid kunci penandatangan aktif
+func (k *Keyring) SigningKeyID() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.signing == nil {
+		return ""
+	}
+	return k.signing.ID
+}
+
+func (k *Keyring) lookup(kid string) *Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.keys[kid]
+}
+
+// claimReload mengizinkan satu reload per reloadInterval, termasuk reload yang gagal,
+// agar token dengan kid acak tidak bisa memaksa direktori kunci dibaca terus-menerus
+func (k *Keyring) claimReload() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.dir == "" || time.Since(k.lastReload) < reloadInterval {
+		return false
+	}
+	k.lastReload = time.Now()
+	return true
+}
+
+func hmacKey(secret string) *Key {
+	return &Key{Algorithm: AlgorithmHS256, private: []byte(secret), public: []byte(secret)}
+}
+
+// ReadDir membaca semua file <kid>.pem di dir
+func ReadDir(dir string) ([]*Key, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*Key, 0, len(paths))
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		if !kidPattern.MatchString(kid) {
+			return nil, fmt.Errorf("keyring: invalid key id %q in %s", kid, path)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: %s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParsePEM membaca kunci privat atau publik RSA/Ed25519 dari PEM
+func ParsePEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := parsed.(type) {
+	case *rsa.PrivateKey:
+		if value.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key must be at least 2048 bits")
+		}
+		return &Key{ID: kid, Algorithm: AlgorithmRS256, private: value, public: &value.PublicKey}, nil
+	case *rsa.PublicKey:
+		if value.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key must be at least 2048 bits")
+		}
+		return &Key{ID: kid, Algorithm: AlgorithmRS256, public: value}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Algorithm: AlgorithmEdDSA, private: value, public: value.Public()}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: kid, Algorithm: AlgorithmEdDSA, public: value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
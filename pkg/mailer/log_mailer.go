@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogMailer tidak mengirim email sungguhan, melainkan menulisnya ke log
+// dan (opsional) ke sebuah file. Dipakai untuk development lokal dan testing.
+type LogMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLogMailer adalah constructor LogMailer, path kosong berarti hanya menulis ke log
+func NewLogMailer(path string) *LogMailer {
+	return &LogMailer{path: path}
+}
+
+// Send menulis isi email ke log dan menambahkannya ke file jika path diisi
+func (m *LogMailer) Send(message Message) error {
+	log.Printf("[LogMailer] To: %s | Subject: %s\n%s", message.To, message.Subject, message.Body)
+
+	if m.path == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n----\n\n",
+		time.Now().Format(time.RFC3339), message.To, message.Subject, message.Body)
+	return err
+}
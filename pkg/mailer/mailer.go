@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"os"
+	"strings"
+)
+
+// Message adalah email plain text yang akan dikirim
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer adalah abstraksi pengiriman email agar implementasinya bisa diganti (SMTP, log, dsb)
+type Mailer interface {
+	Send(message Message) error
+}
+
+// NewFromEnv memilih implementasi Mailer berdasarkan env MAIL_DRIVER.
+// "smtp" memakai SMTPMailer, selain itu memakai LogMailer untuk development dan testing.
+func NewFromEnv() Mailer {
+	switch strings.ToLower(os.Getenv("MAIL_DRIVER")) {
+	case "smtp":
+		return NewSMTPMailer(SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("MAIL_FROM"),
+		})
+	default:
+		return NewLogMailer(os.Getenv("MAIL_LOG_FILE"))
+	}
+}
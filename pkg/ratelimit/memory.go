@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryLimiter menyimpan counter di memori proses. Jumlah key dibatasi dengan LRU dan key yang
+// sudah kedaluwarsa dibuang, sehingga pemakaian memori tetap terbatas walaupun diserang dari banyak IP.
+// Counter tidak dibagi antar replika, gunakan RedisLimiter jika aplikasi dijalankan lebih dari satu instance.
+type MemoryLimiter struct {
+	slidingWindow
+	store *memoryStore
+}
+
+// NewMemoryLimiter adalah constructor MemoryLimiter, maxKeys adalah jumlah counter maksimum yang disimpan
+func NewMemoryLimiter(maxKeys int) *MemoryLimiter {
+	if maxKeys <= 0 {
+		maxKeys = 100000
+	}
+	store := &memoryStore{
+		maxKeys: maxKeys,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+	return &MemoryLimiter{
+		slidingWindow: slidingWindow{store: store, now: time.Now},
+		store:         store,
+	}
+}
+
+// Len mengembalikan jumlah counter yang sedang disimpan
+func (l *MemoryLimiter) Len() int {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+	return l.store.lru.Len()
+}
+
+type memoryEntry struct {
+	key       string
+	count     int64
+	expiresAt time.Time
+}
+
+// memoryStore adalah counterStore dengan LRU, elemen terdepan adalah yang paling baru dipakai
+type memoryStore struct {
+	mu      sync.Mutex
+	maxKeys int
+	entries map[string]*list.Element
+	lru     *list.List
+	now     func() time.Time
+}
+
+func (s *memoryStore) add(_ context.Context, previousKey string, currentKey string, delta int64, ttl time.Duration) (int64, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.evictExpired(now)
+
+	var previous int64
+	if element := s.lookup(previousKey, now); element != nil {
+		previous = element.Value.(*memoryEntry).count
+	}
+
+	element := s.lookup(currentKey, now)
+	if element == nil {
+		element = s.lru.PushFront(&memoryEntry{key: currentKey})
+		s.entries[currentKey] = element
+	}
+	entry := element.Value.(*memoryEntry)
+	entry.count += delta
+	entry.expiresAt = now.Add(ttl)
+
+	for s.lru.Len() > s.maxKeys {
+		s.remove(s.lru.Back())
+	}
+	return previous, entry.count, nil
+}
+
+// lookup mengembalikan elemen yang masih berlaku dan menandainya sebagai baru dipakai
+func (s *memoryStore) lookup(key string, now time.Time) *list.Element {
+	element, ok := s.entries[key]
+	if !ok {
+		return nil
+	}
+	if !now.Before(element.Value.(*memoryEntry).expiresAt) {
+		s.remove(element)
+		return nil
+	}
+	s.lru.MoveToFront(element)
+	return element
+}
+
+// evictExpired membuang elemen kedaluwarsa dari ujung LRU. Elemen yang jarang dipakai berkumpul
+// di ujung sehingga pemeriksaan cukup berhenti di elemen pertama yang masih berlaku.
+func (s *memoryStore) evictExpired(now time.Time) {
+	for element := s.lru.Back(); element != nil; element = s.lru.Back() {
+		if now.Before(element.Value.(*memoryEntry).expiresAt) {
+			return
+		}
+		s.remove(element)
+	}
+}
+
+func (s *memoryStore) remove(element *list.Element) {
+	s.lru.Remove(element)
+	delete(s.entries, element.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterEvictsLeastRecentlyUsed(t *testing.T) {
+	limiter, _ := newTestMemoryLimiter(2)
+	policy := Policy{Name: "login", Limit: 1, Window: time.Minute}
+
+	allow(t, limiter, "a", policy)
+	allow(t, limiter, "b", policy)
+	// Request yang ditolak tetap menandai "a" sebagai baru dipakai sehingga "b" yang dibuang
+	if result := allow(t, limiter, "a", policy); result.Allowed {
+		t.Fatalf("second request for a = %+v, want denied", result)
+	}
+	allow(t, limiter, "c", policy)
+
+	if limiter.Len() != 2 {
+		t.Fatalf("len = %d, want 2", limiter.Len())
+	}
+	if result := allow(t, limiter, "a", policy); result.Allowed {
+		t.Errorf("a = %+v, want still denied", result)
+	}
+	if result := allow(t, limiter, "b", policy); !result.Allowed {
+		t.Errorf("b = %+v, want allowed after eviction", result)
+	}
+}
+
+func TestMemoryLimiterEvictsExpiredKeys(t *testing.T) {
+	limiter, clock := newTestMemoryLimiter(100)
+	policy := Policy{Name: "login", Limit: 5, Window: time.Minute}
+
+	allow(t, limiter, "a", policy)
+	allow(t, limiter, "b", policy)
+	clock.now = clock.now.Add(time.Minute)
+	allow(t, limiter, "b", policy)
+	if limiter.Len() != 3 {
+		t.Fatalf("len = %d, want 3 counters", limiter.Len())
+	}
+
+	// Counter disimpan selama dua jendela, setelah itu dibuang pada request berikutnya dari key apa pun
+	clock.now = clock.now.Add(time.Minute)
+	allow(t, limiter, "c", policy)
+	if limiter.Len() != 2 {
+		t.Fatalf("len = %d, want 2 counters after the first window expired", limiter.Len())
+	}
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	allow(t, limiter, "c", policy)
+	if limiter.Len() != 1 {
+		t.Fatalf("len = %d, want only the new counter", limiter.Len())
+	}
+}
@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-article/pkg/redis"
+	"go-article/pkg/utils"
+)
+
+// Policy adalah batas jumlah request dalam satu jendela waktu, misalnya 5 request per menit
+type Policy struct {
+	// Name memisahkan counter antar policy sehingga key yang sama bisa punya batas berbeda per route
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+// String memformat policy untuk header RateLimit-Policy, misalnya "5;w=60"
+func (p Policy) String() string {
+	return fmt.Sprintf("%d;w=%d", p.Limit, int(math.Ceil(p.Window.Seconds())))
+}
+
+// Result adalah keputusan limiter untuk satu request
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset adalah sisa waktu sampai jendela saat ini berakhir
+	Reset time.Duration
+	// RetryAfter adalah waktu tunggu sampai request berikutnya diizinkan, nol jika Allowed
+	RetryAfter time.Duration
+}
+
+// RateLimiter memutuskan apakah request dengan key tertentu masih boleh diproses menurut policy.
+// Implementasinya harus aman dipakai bersamaan dari banyak goroutine.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// NewFromEnv memilih implementasi RateLimiter berdasarkan env RATE_LIMIT_DRIVER.
+// "redis" berbagi counter antar replika lewat REDIS_ADDR, selain itu counter disimpan di memori
+// setiap proses dengan jumlah key maksimum RATE_LIMIT_MAX_KEYS.
+func NewFromEnv() RateLimiter {
+	switch strings.ToLower(os.Getenv("RATE_LIMIT_DRIVER")) {
+	case "redis":
+		return NewRedisLimiter(redis.NewClient(redis.Options{
+			Addr:     os.Getenv("REDIS_ADDR"),
+			Password: os.Getenv("REDIS_PASSWORD"),
+			DB:       utils.IntFromEnv("REDIS_DB", 0),
+		}))
+	default:
+		return NewMemoryLimiter(utils.IntFromEnv("RATE_LIMIT_MAX_KEYS", 100000))
+	}
+}
+
+// PolicyFromEnv membuat policy dengan batas bawaan yang bisa diganti lewat env
+// RATE_LIMIT_<NAME> berformat "<limit>/<window>", misalnya RATE_LIMIT_AUTH_LOGIN=5/1m.
+func PolicyFromEnv(name string, limit int, window time.Duration) Policy {
+	policy := Policy{Name: name, Limit: limit, Window: window}
+
+	key := "RATE_LIMIT_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+	value := os.Getenv(key)
+	if value == "" {
+		return policy
+	}
+
+	rawLimit, rawWindow, ok := strings.Cut(value, "/")
+	envLimit, limitErr := strconv.Atoi(strings.TrimSpace(rawLimit))
+	envWindow, windowErr := time.ParseDuration(strings.TrimSpace(rawWindow))
+	if !ok || limitErr != nil || windowErr != nil || envLimit <= 0 || envWindow <= 0 {
+		log.Printf("[RateLimit] Invalid %s=%q, using %d/%s", key, value, limit, window)
+		return policy
+	}
+
+	policy.Limit = envLimit
+	policy.Window = envWindow
+	return policy
+}
+
+// counterStore menyimpan counter per jendela waktu untuk slidingWindow
+type counterStore interface {
+	// add menambah counter currentKey sebanyak delta dan memperbarui masa berlakunya menjadi ttl,
+	// lalu mengembalikan counter previousKey dan currentKey setelah penambahan
+	add(ctx context.Context, previousKey string, currentKey string, delta int64, ttl time.Duration) (int64, int64, error)
+}
+
+// slidingWindow menerapkan algoritma sliding window counter di atas counterStore.
+// Jumlah request diperkirakan dari counter jendela saat ini ditambah counter jendela sebelumnya
+// yang dibobot sesuai sisa tumpang tindihnya, sehingga tidak ada lonjakan dua kali lipat di batas jendela
+// seperti fixed window, dan cukup dua counter per key. Counter dinaikkan lebih dulu secara atomik
+// lalu dikembalikan jika request ditolak, sehingga aman dipakai bersamaan oleh banyak replika.
+type slidingWindow struct {
+	store counterStore
+	now   func() time.Time
+}
+
+func (l *slidingWindow) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	if policy.Limit <= 0 || policy.Window <= 0 {
+		return Result{Allowed: true, Limit: policy.Limit}, nil
+	}
+
+	now := l.now()
+	start := now.Truncate(policy.Window)
+	elapsed := now.Sub(start)
+	prefix := "ratelimit:" + policy.Name + ":" + key + ":"
+	previousKey := prefix + strconv.FormatInt(start.Add(-policy.Window).UnixMilli(), 10)
+	currentKey := prefix + strconv.FormatInt(start.UnixMilli(), 10)
+
+	// Counter jendela saat ini masih dibaca sebagai jendela sebelumnya selama satu jendela berikutnya
+	previous, current, err := l.store.add(ctx, previousKey, currentKey, 1, 2*policy.Window)
+	if err != nil {
+		return Result{}, err
+	}
+
+	weight := 1 - float64(elapsed)/float64(policy.Window)
+	estimate := float64(previous)*weight + float64(current)
+	result := Result{
+		Allowed:   estimate <= float64(policy.Limit),
+		Limit:     policy.Limit,
+		Remaining: int(math.Max(0, math.Floor(float64(policy.Limit)-estimate))),
+		Reset:     policy.Window - elapsed,
+	}
+	if result.Allowed {
+		return result, nil
+	}
+
+	// Request yang ditolak tidak dihitung agar client yang terus mencoba tidak terkunci lebih lama
+	if _, _, err := l.store.add(ctx, previousKey, currentKey, -1, 2*policy.Window); err != nil {
+		log.Println("[RateLimit] Allow:", err)
+	}
+	result.RetryAfter = retryAfter(policy, elapsed, previous, current-1)
+	return result, nil
+}
+
+// retryAfter menghitung kapan perkiraan jumlah request turun cukup untuk satu request lagi.
+// previous dan current adalah counter tanpa request yang ditolak.
+func retryAfter(policy Policy, elapsed time.Duration, previous int64, current int64) time.Duration {
+	limit := float64(policy.Limit)
+	window := float64(policy.Window)
+
+	if float64(current)+1 > limit {
+		// Jendela ini sudah penuh, tunggu jendela berikutnya sampai bobot counter ini cukup kecil
+		wait := float64(policy.Window-elapsed) + window*(1-(limit-1)/float64(current))
+		return time.Duration(math.Ceil(wait))
+	}
+
+	// Sisa kuota terpakai oleh jendela sebelumnya, tunggu sampai bobotnya turun
+	wait := window*(1-(limit-1-float64(current))/float64(previous)) - float64(elapsed)
+	return time.Duration(math.Max(0, math.Ceil(wait)))
+}
@@ -0,0 +1,216 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-article/pkg/redis"
+	"go-article/pkg/redis/redistest"
+)
+
+// fakeClock adalah jam yang hanya maju jika field now diubah oleh test
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+// testLimiter adalah limiter dengan jam palsu, advance memajukan jam limiter beserta penyimpanan counter-nya
+type testLimiter struct {
+	RateLimiter
+	advance func(d time.Duration)
+}
+
+// newTestClock dimulai tepat di awal jendela menit agar posisi dalam jendela mudah dihitung
+func newTestClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func newTestMemoryLimiter(maxKeys int) (*MemoryLimiter, *fakeClock) {
+	clock := newTestClock()
+	limiter := NewMemoryLimiter(maxKeys)
+	limiter.now = clock.Now
+	limiter.store.now = clock.Now
+	return limiter, clock
+}
+
+func newTestRedisLimiter(t *testing.T) (*RedisLimiter, *redistest.Server, *fakeClock) {
+	t.Helper()
+
+	srv, err := redistest.NewServer("")
+	if err != nil {
+		t.Fatalf("redistest.NewServer: %v", err)
+	}
+	limiter := NewRedisLimiter(redis.NewClient(redis.Options{Addr: srv.Addr()}))
+	t.Cleanup(func() {
+		limiter.Close()
+		srv.Close()
+	})
+
+	clock := newTestClock()
+	limiter.now = clock.Now
+	return limiter, srv, clock
+}
+
+// limiters menjalankan test yang sama untuk setiap implementasi RateLimiter
+func limiters() map[string]func(t *testing.T) testLimiter {
+	return map[string]func(t *testing.T) testLimiter{
+		"memory": func(t *testing.T) testLimiter {
+			limiter, clock := newTestMemoryLimiter(100)
+			return testLimiter{RateLimiter: limiter, advance: func(d time.Duration) { clock.now = clock.now.Add(d) }}
+		},
+		"redis": func(t *testing.T) testLimiter {
+			limiter, srv, clock := newTestRedisLimiter(t)
+			return testLimiter{RateLimiter: limiter, advance: func(d time.Duration) {
+				clock.now = clock.now.Add(d)
+				srv.FastForward(d)
+			}}
+		},
+	}
+}
+
+func allow(t *testing.T, limiter RateLimiter, key string, policy Policy) Result {
+	t.Helper()
+
+	result, err := limiter.Allow(context.Background(), key, policy)
+	if err != nil {
+		t.Fatalf("Allow(%q): %v", key, err)
+	}
+	return result
+}
+
+func TestAllowUntilLimit(t *testing.T) {
+	policy := Policy{Name: "login", Limit: 3, Window: time.Minute}
+
+	for name, newLimiter := range limiters() {
+		t.Run(name, func(t *testing.T) {
+			limiter := newLimiter(t)
+
+			for i, remaining := range []int{2, 1, 0} {
+				result := allow(t, limiter, "1.2.3.4", policy)
+				if !result.Allowed || result.Remaining != remaining || result.Limit != 3 || result.RetryAfter != 0 {
+					t.Fatalf("request %d = %+v, want allowed with %d remaining", i+1, result, remaining)
+				}
+			}
+
+			result := allow(t, limiter, "1.2.3.4", policy)
+			if result.Allowed || result.Remaining != 0 {
+				t.Fatalf("request over limit = %+v, want denied", result)
+			}
+			if result.Reset != time.Minute {
+				t.Errorf("reset = %s, want %s", result.Reset, time.Minute)
+			}
+
+			// Key dan policy lain punya counter sendiri
+			if result := allow(t, limiter, "5.6.7.8", policy); !result.Allowed {
+				t.Errorf("other key = %+v, want allowed", result)
+			}
+			if result := allow(t, limiter, "1.2.3.4", Policy{Name: "register", Limit: 3, Window: time.Minute}); !result.Allowed {
+				t.Errorf("other policy = %+v, want allowed", result)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	policy := Policy{Name: "login", Limit: 3, Window: time.Minute}
+
+	for name, newLimiter := range limiters() {
+		t.Run(name, func(t *testing.T) {
+			limiter := newLimiter(t)
+			for range policy.Limit {
+				allow(t, limiter, "1.2.3.4", policy)
+			}
+
+			denied := allow(t, limiter, "1.2.3.4", policy)
+			if denied.Allowed {
+				t.Fatalf("request over limit = %+v, want denied", denied)
+			}
+			// Tiga request di jendela sebelumnya harus berbobot 2/3 agar satu request lagi muat,
+			// yaitu 20 detik setelah jendela berikutnya dimulai
+			if denied.RetryAfter < 80*time.Second || denied.RetryAfter > 80*time.Second+time.Millisecond {
+				t.Fatalf("retry after = %s, want 80s", denied.RetryAfter)
+			}
+
+			// Request yang ditolak tidak dihitung, sehingga mencoba terus tidak memperpanjang waktu tunggu
+			limiter.advance(denied.RetryAfter - time.Second)
+			if result := allow(t, limiter, "1.2.3.4", policy); result.Allowed {
+				t.Fatalf("request before retry after = %+v, want denied", result)
+			}
+			limiter.advance(time.Second)
+			if result := allow(t, limiter, "1.2.3.4", policy); !result.Allowed {
+				t.Fatalf("request at retry after = %+v, want allowed", result)
+			}
+		})
+	}
+}
+
+func TestWindowSlides(t *testing.T) {
+	policy := Policy{Name: "comment", Limit: 4, Window: time.Minute}
+
+	for name, newLimiter := range limiters() {
+		t.Run(name, func(t *testing.T) {
+			limiter := newLimiter(t)
+			for range policy.Limit {
+				allow(t, limiter, "user:1", policy)
+			}
+
+			// Di tengah jendela berikutnya empat request sebelumnya masih dihitung separuh,
+			// sehingga hanya dua request lagi yang diizinkan, bukan empat seperti fixed window
+			limiter.advance(policy.Window + policy.Window/2)
+			for i, want := range []bool{true, true, false} {
+				if result := allow(t, limiter, "user:1", policy); result.Allowed != want {
+					t.Fatalf("request %d half way = %+v, want allowed %v", i+1, result, want)
+				}
+			}
+
+			// Dua jendela kemudian counter lama sudah tidak dihitung sama sekali
+			limiter.advance(2 * policy.Window)
+			if result := allow(t, limiter, "user:1", policy); !result.Allowed || result.Remaining != policy.Limit-1 {
+				t.Fatalf("request after two windows = %+v, want allowed with %d remaining", result, policy.Limit-1)
+			}
+		})
+	}
+}
+
+func TestAllowWithoutLimit(t *testing.T) {
+	limiter, _ := newTestMemoryLimiter(100)
+
+	for _, policy := range []Policy{{Name: "off", Limit: 0, Window: time.Minute}, {Name: "off", Limit: 1, Window: 0}} {
+		for range 3 {
+			if result := allow(t, limiter, "key", policy); !result.Allowed {
+				t.Fatalf("policy %+v = %+v, want always allowed", policy, result)
+			}
+		}
+	}
+	if limiter.Len() != 0 {
+		t.Errorf("len = %d, want no counters for disabled policies", limiter.Len())
+	}
+}
+
+func TestPolicyFromEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  Policy
+	}{
+		{"unset", "", Policy{Name: "auth.login", Limit: 5, Window: time.Minute}},
+		{"override", "10/30s", Policy{Name: "auth.login", Limit: 10, Window: 30 * time.Second}},
+		{"spaces", " 20 / 1h ", Policy{Name: "auth.login", Limit: 20, Window: time.Hour}},
+		{"missing window", "10", Policy{Name: "auth.login", Limit: 5, Window: time.Minute}},
+		{"invalid limit", "x/1m", Policy{Name: "auth.login", Limit: 5, Window: time.Minute}},
+		{"zero limit", "0/1m", Policy{Name: "auth.login", Limit: 5, Window: time.Minute}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMIT_AUTH_LOGIN", tt.value)
+			if got := PolicyFromEnv("auth.login", 5, time.Minute); got != tt.want {
+				t.Errorf("PolicyFromEnv() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
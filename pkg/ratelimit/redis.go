@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go-article/pkg/redis"
+)
+
+// RedisLimiter menyimpan counter di server yang kompatibel dengan Redis sehingga batas berlaku
+// untuk semua replika aplikasi. Setiap keputusan cukup satu round trip (GET, INCRBY dan PEXPIRE
+// dalam satu pipeline), dan counter dibuang otomatis oleh server setelah dua jendela.
+type RedisLimiter struct {
+	slidingWindow
+	client *redis.Client
+}
+
+// NewRedisLimiter adalah constructor RedisLimiter
+func NewRedisLimiter(client *redis.Client) *RedisLimiter {
+	return &RedisLimiter{
+		slidingWindow: slidingWindow{store: redisStore{client: client}, now: time.Now},
+		client:        client,
+	}
+}
+
+// Close menutup koneksi ke server
+func (l *RedisLimiter) Close() error {
+	return l.client.Close()
+}
+
+type redisStore struct {
+	client *redis.Client
+}
+
+func (s redisStore) add(ctx context.Context, previousKey string, currentKey string, delta int64, ttl time.Duration) (int64, int64, error) {
+	replies, err := s.client.Pipeline(ctx,
+		[]string{"GET", previousKey},
+		[]string{"INCRBY", currentKey, strconv.FormatInt(delta, 10)},
+		[]string{"PEXPIRE", currentKey, strconv.FormatInt(ttl.Milliseconds(), 10)},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	previous, err := redis.Int(replies[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	current, err := redis.Int(replies[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return previous, current, nil
+}
@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRedisLimiterExpiresCounters(t *testing.T) {
+	limiter, srv, _ := newTestRedisLimiter(t)
+	policy := Policy{Name: "login", Limit: 5, Window: time.Minute}
+
+	allow(t, limiter, "a", policy)
+	allow(t, limiter, "b", policy)
+	if srv.Keys() != 2 {
+		t.Fatalf("keys = %d, want one counter per key", srv.Keys())
+	}
+
+	srv.FastForward(2*policy.Window - time.Second)
+	if srv.Keys() != 2 {
+		t.Fatalf("keys = %d, want counters kept for two windows", srv.Keys())
+	}
+	srv.FastForward(time.Second)
+	if srv.Keys() != 0 {
+		t.Fatalf("keys = %d, want counters expired after two windows", srv.Keys())
+	}
+}
+
+func TestRedisLimiterServerDown(t *testing.T) {
+	limiter, srv, _ := newTestRedisLimiter(t)
+	srv.Close()
+
+	if _, err := limiter.Allow(t.Context(), "a", Policy{Name: "login", Limit: 5, Window: time.Minute}); err == nil {
+		t.Fatal("Allow with server down returned no error")
+	}
+}
@@ -0,0 +1,271 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Error adalah balasan error dari server, misalnya "WRONGTYPE ..." atau "NOAUTH ..."
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+// Options adalah konfigurasi koneksi Client
+type Options struct {
+	Addr     string
+	Password string
+	DB       int
+	// PoolSize adalah jumlah maksimum koneksi idle yang disimpan untuk dipakai ulang
+	PoolSize int
+	// Timeout adalah batas waktu dial dan satu round trip jika context tidak punya deadline
+	Timeout time.Duration
+}
+
+// Client adalah klien minimal untuk server yang berbicara protokol Redis (RESP2), misalnya Redis,
+// Valkey atau KeyDB. Client aman dipakai bersamaan dari banyak goroutine dan mendukung pipeline
+// sehingga beberapa perintah cukup dikirim dalam satu round trip.
+type Client struct {
+	options Options
+	idle    chan *conn
+}
+
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+	writer  *bufio.Writer
+}
+
+// NewClient adalah constructor Client, koneksi baru dibuka saat dibutuhkan
+func NewClient(options Options) *Client {
+	if options.Addr == "" {
+		options.Addr = "127.0.0.1:6379"
+	}
+	if options.PoolSize <= 0 {
+		options.PoolSize = 10
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 3 * time.Second
+	}
+	return &Client{options: options, idle: make(chan *conn, options.PoolSize)}
+}
+
+// Do menjalankan satu perintah dan mengembalikan balasannya: string, int64, []interface{}
+// atau nil jika key tidak ada. Balasan error dari server dikembalikan sebagai Error.
+func (c *Client) Do(ctx context.Context, args ...string) (interface{}, error) {
+	replies, err := c.Pipeline(ctx, args)
+	if len(replies) == 0 {
+		return nil, err
+	}
+	return replies[0], err
+}
+
+// Pipeline mengirim beberapa perintah sekaligus lalu membaca semua balasannya secara berurutan.
+// Jika salah satu perintah dibalas error, balasan lain tetap dikembalikan bersama error pertama.
+func (c *Client) Pipeline(ctx context.Context, commands ...[]string) ([]interface{}, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := cn.roundTrip(ctx, c.options.Timeout, commands)
+	if err != nil && !isReplyError(err) {
+		// Koneksi yang gagal di tengah jalan bisa menyisakan balasan yang belum terbaca
+		cn.netConn.Close()
+		return nil, err
+	}
+
+	c.put(cn)
+	return replies, err
+}
+
+// Ping memastikan server bisa dihubungi
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Do(ctx, "PING")
+	return err
+}
+
+// Close menutup semua koneksi idle
+func (c *Client) Close() error {
+	for {
+		select {
+		case cn := <-c.idle:
+			cn.netConn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context) (*conn, error) {
+	select {
+	case cn := <-c.idle:
+		return cn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: c.options.Timeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", c.options.Addr)
+	if err != nil {
+		return nil, err
+	}
+	cn := &conn{netConn: netConn, reader: bufio.NewReader(netConn), writer: bufio.NewWriter(netConn)}
+
+	var setup [][]string
+	if c.options.Password != "" {
+		setup = append(setup, []string{"AUTH", c.options.Password})
+	}
+	if c.options.DB != 0 {
+		setup = append(setup, []string{"SELECT", strconv.Itoa(c.options.DB)})
+	}
+	if len(setup) > 0 {
+		if _, err := cn.roundTrip(ctx, c.options.Timeout, setup); err != nil {
+			netConn.Close()
+			return nil, err
+		}
+	}
+	return cn, nil
+}
+
+func (c *Client) put(cn *conn) {
+	select {
+	case c.idle <- cn:
+	default:
+		cn.netConn.Close()
+	}
+}
+
+func (cn *conn) roundTrip(ctx context.Context, timeout time.Duration, commands [][]string) ([]interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(timeout)
+	}
+	if err := cn.netConn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	for _, args := range commands {
+		if err := writeCommand(cn.writer, args); err != nil {
+			return nil, err
+		}
+	}
+	if err := cn.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	replies := make([]interface{}, len(commands))
+	var firstErr error
+	for i := range commands {
+		reply, err := ReadReply(cn.reader)
+		if err != nil && !isReplyError(err) {
+			return nil, err
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		replies[i] = reply
+	}
+	return replies, firstErr
+}
+
+func isReplyError(err error) bool {
+	var replyErr Error
+	return errors.As(err, &replyErr)
+}
+
+// writeCommand menulis perintah sebagai array bulk string
+func writeCommand(w *bufio.Writer, args []string) error {
+	fmt.Fprintf(w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(w, "$%d\r\n", len(arg))
+		w.WriteString(arg)
+		if _, err := w.WriteString("\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadReply membaca satu balasan RESP. Balasan error dikembalikan sebagai Error, bulk string nil sebagai nil.
+func ReadReply(r *bufio.Reader) (interface{}, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:size]), nil
+	case '*':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, size)
+		for i := range items {
+			item, err := ReadReply(r)
+			if err != nil && !isReplyError(err) {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("redis: unexpected reply %q", line)
+	}
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("redis: malformed line %q", line)
+	}
+	return line[:len(line)-2], nil
+}
+
+// Int mengubah balasan menjadi int64, balasan nil dianggap 0
+func Int(reply interface{}) (int64, error) {
+	switch value := reply.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return value, nil
+	case string:
+		return strconv.ParseInt(value, 10, 64)
+	default:
+		return 0, fmt.Errorf("redis: unexpected reply type %T", reply)
+	}
+}
@@ -0,0 +1,338 @@
+package redistest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go-article/pkg/redis"
+)
+
+// Server adalah server Redis palsu yang berjalan di dalam proses untuk pengujian dan development
+// tanpa Redis sungguhan. Hanya subset perintah yang dipakai aplikasi yang didukung:
+// PING, AUTH, SELECT, GET, SET, DEL, EXISTS, INCR, INCRBY, DECR, PEXPIRE, EXPIRE, PTTL dan FLUSHALL.
+type Server struct {
+	listener net.Listener
+	password string
+
+	mu     sync.Mutex
+	data   map[string]*entry
+	offset time.Duration
+	conns  map[net.Conn]struct{}
+	wg     sync.WaitGroup
+}
+
+type entry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewServer menjalankan Server di port acak pada 127.0.0.1, password kosong berarti tanpa AUTH
+func NewServer(password string) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		listener: listener,
+		password: password,
+		data:     make(map[string]*entry),
+		conns:    make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Addr adalah alamat host:port yang bisa dipakai sebagai redis.Options.Addr
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// FastForward memajukan jam server sehingga key yang punya TTL bisa kedaluwarsa tanpa menunggu
+func (s *Server) FastForward(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.offset += d
+}
+
+// Keys mengembalikan jumlah key yang belum kedaluwarsa
+func (s *Server) Keys() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for key := range s.data {
+		if s.lookup(key) != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// Close menghentikan server dan menutup semua koneksi client
+func (s *Server) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.handle(c)
+	}
+}
+
+func (s *Server) handle(c net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		c.Close()
+	}()
+
+	reader := bufio.NewReader(c)
+	writer := bufio.NewWriter(c)
+	authenticated := s.password == ""
+
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				writeReply(writer, redis.Error("ERR Protocol error: "+err.Error()))
+				writer.Flush()
+			}
+			return
+		}
+
+		var reply interface{}
+		name := strings.ToUpper(args[0])
+		switch {
+		case name == "AUTH":
+			if len(args) == 2 && args[1] == s.password {
+				authenticated = true
+				reply = "OK"
+			} else {
+				reply = redis.Error("WRONGPASS invalid password")
+			}
+		case !authenticated:
+			reply = redis.Error("NOAUTH Authentication required.")
+		default:
+			reply = s.exec(name, args[1:])
+		}
+
+		writeReply(writer, reply)
+		// Balasan pipeline dikirim sekaligus setelah semua perintah yang sudah tiba diproses
+		if reader.Buffered() == 0 {
+			if err := writer.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) exec(name string, args []string) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch name {
+	case "PING":
+		return "PONG"
+	case "SELECT":
+		return "OK"
+	case "FLUSHALL", "FLUSHDB":
+		s.data = make(map[string]*entry)
+		return "OK"
+	case "GET":
+		if len(args) != 1 {
+			return wrongArgs(name)
+		}
+		if e := s.lookup(args[0]); e != nil {
+			return e.value
+		}
+		return nil
+	case "SET":
+		if len(args) < 2 {
+			return wrongArgs(name)
+		}
+		e := &entry{value: args[1]}
+		for i := 2; i < len(args); i++ {
+			option := strings.ToUpper(args[i])
+			if (option == "PX" || option == "EX") && i+1 < len(args) {
+				ttl, err := strconv.ParseInt(args[i+1], 10, 64)
+				if err != nil || ttl <= 0 {
+					return redis.Error("ERR invalid expire time in 'set' command")
+				}
+				unit := time.Millisecond
+				if option == "EX" {
+					unit = time.Second
+				}
+				e.expiresAt = s.now().Add(time.Duration(ttl) * unit)
+				i++
+			}
+		}
+		s.data[args[0]] = e
+		return "OK"
+	case "DEL", "EXISTS":
+		count := int64(0)
+		for _, key := range args {
+			if s.lookup(key) != nil {
+				count++
+				if name == "DEL" {
+					delete(s.data, key)
+				}
+			}
+		}
+		return count
+	case "INCR", "DECR", "INCRBY", "DECRBY":
+		delta := int64(1)
+		if name == "INCRBY" || name == "DECRBY" {
+			if len(args) != 2 {
+				return wrongArgs(name)
+			}
+			var err error
+			if delta, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+				return redis.Error("ERR value is not an integer or out of range")
+			}
+		} else if len(args) != 1 {
+			return wrongArgs(name)
+		}
+		if name == "DECR" || name == "DECRBY" {
+			delta = -delta
+		}
+		return s.incr(args[0], delta)
+	case "PEXPIRE", "EXPIRE":
+		if len(args) != 2 {
+			return wrongArgs(name)
+		}
+		ttl, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return redis.Error("ERR value is not an integer or out of range")
+		}
+		e := s.lookup(args[0])
+		if e == nil {
+			return int64(0)
+		}
+		unit := time.Millisecond
+		if name == "EXPIRE" {
+			unit = time.Second
+		}
+		e.expiresAt = s.now().Add(time.Duration(ttl) * unit)
+		return int64(1)
+	case "PTTL":
+		if len(args) != 1 {
+			return wrongArgs(name)
+		}
+		e := s.lookup(args[0])
+		if e == nil {
+			return int64(-2)
+		}
+		if e.expiresAt.IsZero() {
+			return int64(-1)
+		}
+		return e.expiresAt.Sub(s.now()).Milliseconds()
+	default:
+		return redis.Error(fmt.Sprintf("ERR unknown command '%s'", strings.ToLower(name)))
+	}
+}
+
+func (s *Server) incr(key string, delta int64) interface{} {
+	e := s.lookup(key)
+	if e == nil {
+		e = &entry{value: "0"}
+		s.data[key] = e
+	}
+	value, err := strconv.ParseInt(e.value, 10, 64)
+	if err != nil {
+		return redis.Error("ERR value is not an integer or out of range")
+	}
+	value += delta
+	e.value = strconv.FormatInt(value, 10)
+	return value
+}
+
+// lookup mengembalikan entry yang masih berlaku dan menghapus entry yang sudah kedaluwarsa
+func (s *Server) lookup(key string) *entry {
+	e, ok := s.data[key]
+	if !ok {
+		return nil
+	}
+	if !e.expiresAt.IsZero() && !s.now().Before(e.expiresAt) {
+		delete(s.data, key)
+		return nil
+	}
+	return e
+}
+
+func (s *Server) now() time.Time {
+	return time.Now().Add(s.offset)
+}
+
+func wrongArgs(name string) redis.Error {
+	return redis.Error(fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(name)))
+}
+
+// readCommand membaca satu perintah berupa array bulk string
+func readCommand(r *bufio.Reader) ([]string, error) {
+	reply, err := redis.ReadReply(r)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := reply.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, errors.New("expected array of bulk strings")
+	}
+	args := make([]string, len(items))
+	for i, item := range items {
+		arg, ok := item.(string)
+		if !ok {
+			return nil, errors.New("expected bulk string")
+		}
+		args[i] = arg
+	}
+	return args, nil
+}
+
+func writeReply(w *bufio.Writer, reply interface{}) {
+	switch value := reply.(type) {
+	case nil:
+		w.WriteString("$-1\r\n")
+	case redis.Error:
+		fmt.Fprintf(w, "-%s\r\n", string(value))
+	case int64:
+		fmt.Fprintf(w, ":%d\r\n", value)
+	case string:
+		if value == "OK" || value == "PONG" {
+			fmt.Fprintf(w, "+%s\r\n", value)
+			return
+		}
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(value), value)
+	}
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor dikembalikan jika cursor rusak, tanda tangannya tidak cocok, atau milik daftar lain
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor adalah posisi keyset pada daftar yang diurutkan berdasarkan (Key, ID).
+// Cursor dikirim ke client sebagai token opaque yang ditandatangani sehingga tidak bisa dimanipulasi.
+type Cursor struct {
+	// Scope mengikat cursor ke daftar dan urutannya, misalnya "articles" atau "users:-name"
+	Scope string `json:"s"`
+	// Key adalah nilai kolom urutan item, waktu disimpan dalam format RFC3339Nano
+	Key string `json:"k"`
+	ID  uint64 `json:"i"`
+	// Backward true berarti cursor mengambil halaman sebelum posisi ini
+	Backward bool `json:"b,omitempty"`
+}
+
+// TimeCursorKey mengubah waktu menjadi Key cursor
+func TimeCursorKey(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Time membaca Key cursor yang dibuat dengan TimeCursorKey
+func (c Cursor) Time() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+// EncodeCursor menandatangani cursor dengan HMAC-SHA256 dan mengubahnya menjadi token base64url
+func EncodeCursor(cursor Cursor) (string, error) {
+	secret, err := cursorSecret()
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signCursor(payload, secret)), nil
+}
+
+// DecodeCursor memverifikasi token cursor dan memastikan cursor dibuat untuk scope yang sama
+func DecodeCursor(token string, scope string) (*Cursor, error) {
+	secret, err := cursorSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, signCursor(payload, secret)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.Scope != scope {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// NewCursorMeta membuat metadata pagination cursor untuk satu halaman.
+// current adalah cursor dari request (nil untuk halaman pertama), positions adalah posisi setiap item
+// sesuai urutan tampil, dan hasMore menandakan masih ada item lain searah navigasi.
+func NewCursorMeta(limit int, current *Cursor, positions []Cursor, hasMore bool) (CursorMeta, error) {
+	meta := CursorMeta{Limit: limit}
+	if len(positions) == 0 {
+		return meta, nil
+	}
+
+	backward := current != nil && current.Backward
+	// Halaman yang dicapai dengan mundur pasti punya halaman berikutnya, begitu juga sebaliknya
+	hasNext := hasMore || backward
+	hasPrev := (backward && hasMore) || (!backward && current != nil)
+
+	if hasNext {
+		next := positions[len(positions)-1]
+		next.Backward = false
+		token, err := EncodeCursor(next)
+		if err != nil {
+			return CursorMeta{}, err
+		}
+		meta.NextCursor = &token
+	}
+	if hasPrev {
+		prev := positions[0]
+		prev.Backward = true
+		token, err := EncodeCursor(prev)
+		if err != nil {
+			return CursorMeta{}, err
+		}
+		meta.PrevCursor = &token
+	}
+	return meta, nil
+}
+
+func signCursor(payload []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("cursor:"))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// cursorSecret membaca kunci penandatangan cursor dari CURSOR_SECRET, atau JWT_SECRET jika kosong
+func cursorSecret() (string, error) {
+	if secret := os.Getenv("CURSOR_SECRET"); secret != "" {
+		return secret, nil
+	}
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		return secret, nil
+	}
+	return "", errors.New("CURSOR_SECRET or JWT_SECRET environment variable is not set")
+}
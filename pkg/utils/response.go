@@ -1,11 +1,5 @@
 package utils
 
-import (
-	"fmt"
-
-	"github.com/go-playground/validator/v10"
-)
-
 type Meta struct {
 	Code    int    `json:"code"`
 	Status  string `json:"status"`
@@ -19,6 +13,13 @@ type PaginationMeta struct {
 	TotalItems  int64 `json:"total_items"`
 }
 
+// CursorMeta adalah metadata pagination berbasis cursor, cursor bernilai null jika tidak ada halaman ke arah tersebut
+type CursorMeta struct {
+	Limit      int     `json:"limit"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+}
+
 type Response struct {
 	Meta interface{} `json:"meta"` // Changed to interface{} to support both Meta and PaginationMeta inside specific structure if needed, or just handle at helper level.
 	// Actually, let's keep it simple. Standard Meta is for status. We can put pagination in Data or separate field.
@@ -27,9 +28,16 @@ type Response struct {
 	// Let's add Pagination field
 	Data       interface{}     `json:"data,omitempty"`
 	Pagination *PaginationMeta `json:"pagination,omitempty"`
+	Cursor     *CursorMeta     `json:"cursor,omitempty"`
 	Errors     interface{}     `json:"errors,omitempty"`
 }
 
+// ErrorBody adalah isi field errors untuk response error dari middleware.ErrorHandler
+type ErrorBody struct {
+	Code    string      `json:"code"`
+	Details interface{} `json:"details,omitempty"`
+}
+
 // APIResponse membuat format response JSON yang standar
 func APIResponse(message string, code int, status string, data interface{}, errors interface{}) Response {
 	meta := Meta{
@@ -64,13 +72,34 @@ func APIResponseWithPagination(message string, code int, status string, data int
 	return jsonResponse
 }
 
-// FormatValidationError mengubah error validasi menjadi slice string
-func FormatValidationError(err error) []string {
-	var errors []string
+// APIResponseWithCursor membuat format response JSON dengan metadata pagination cursor
+func APIResponseWithCursor(message string, code int, status string, data interface{}, cursor CursorMeta) Response {
+	meta := Meta{
+		Code:    code,
+		Status:  status,
+		Message: message,
+	}
+
+	jsonResponse := Response{
+		Meta:   meta,
+		Data:   data,
+		Cursor: &cursor,
+	}
+
+	return jsonResponse
+}
 
-	for _, e := range err.(validator.ValidationErrors) {
-		errors = append(errors, fmt.Sprintf("Field %s failed on the '%s' tag", e.Field(), e.Tag()))
+// NewPaginationMeta menghitung metadata pagination dari halaman, limit dan total data
+func NewPaginationMeta(page int, limit int, total int64) PaginationMeta {
+	totalPages := 0
+	if limit > 0 {
+		totalPages = int((total + int64(limit) - 1) / int64(limit))
 	}
 
-	return errors
+	return PaginationMeta{
+		CurrentPage: page,
+		TotalPages:  totalPages,
+		Limit:       limit,
+		TotalItems:  total,
+	}
 }
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength adalah panjang maksimal slug dasar, menyisakan ruang untuk suffix angka di kolom VARCHAR(255)
+const MaxSlugLength = 200
+
+// transliterations berisi huruf yang tidak bisa dipecah menjadi huruf ASCII + tanda diakritik lewat NFD,
+// termasuk huruf Kiril, Yunani dan Arab. Kunci berupa huruf kecil karena teks di-lowercase lebih dulu.
+var transliterations = map[rune]string{
+	// Latin
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th",
+	'ł': "l", 'ı': "i", 'ŋ': "ng", 'ħ': "h", 'ŧ': "t", 'ĸ': "k",
+	'‘': "", '’': "", 'ʼ': "", '\'': "",
+
+	// Kiril (Rusia, Ukraina, Belarus, Serbia, Makedonia, Bulgaria)
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+
+	// Yunani (ELOT 743 disederhanakan), huruf bertanda aksen sudah dipecah oleh NFD
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+	'ω': "o",
+
+	// Arab, harakat adalah tanda diakritik (Mn) sehingga ikut dibuang
+	'ا': "a", 'أ': "a", 'إ': "i", 'آ': "a", 'ب': "b", 'ت': "t", 'ث': "th", 'ج': "j",
+	'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s", 'ش': "sh",
+	'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z", 'ع': "", 'غ': "gh", 'ف': "f", 'ق': "q",
+	'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y", 'ى': "a",
+	'ة': "h", 'ء': "", 'ؤ': "w", 'ئ': "y",
+	'٠': "0", '١': "1", '٢': "2", '٣': "3", '٤': "4", '٥': "5", '٦': "6", '٧': "7", '٨': "8", '٩': "9",
+}
+
+// Slugify mengubah teks menjadi slug huruf kecil ASCII yang dipisahkan tanda hubung.
+// Huruf beraksen ditransliterasi ke padanan ASCII-nya ("Kafé Ibu Déwi" menjadi "kafe-ibu-dewi"), begitu juga
+// huruf Kiril, Yunani dan Arab ("Привет мир" menjadi "privet-mir"). Huruf dari aksara lain (misalnya CJK)
+// ditulis sebagai segmen tersendiri berisi kode Unicode setiap huruf, yaitu "u" diikuti enam digit heksadesimal
+// ("Go入门" menjadi "go-u005165u0095e8"), sehingga slug tetap stabil. Slug tidak dijamin unik karena teks ASCII
+// bisa menghasilkan segmen yang sama, keunikan dijaga oleh suffix angka saat disimpan. Apostrof dihapus
+// tanpa memecah kata ("Jum'at" menjadi "jumat"), dan hasilnya dipotong di batas kata jika lebih dari
+// MaxSlugLength karakter.
+func Slugify(text string) string {
+	var b strings.Builder
+	lastDash, lastEncoded := true, false
+
+	// write menambahkan bagian slug, tanda hubung disisipkan saat berpindah dari kata ASCII ke kode Unicode
+	// atau sebaliknya agar segmen kode Unicode tidak menempel pada kata lain
+	write := func(part string, encoded bool) {
+		if part == "" {
+			return
+		}
+		if !lastDash && encoded != lastEncoded {
+			b.WriteByte('-')
+		}
+		b.WriteString(part)
+		lastDash, lastEncoded = false, encoded
+	}
+
+	for _, r := range norm.NFC.String(strings.ToLower(text)) {
+		// Huruf seperti "й" dan "ё" dicari sebelum NFD agar tidak kehilangan bagian yang membedakannya
+		if replacement, ok := transliterations[r]; ok {
+			write(replacement, false)
+			continue
+		}
+
+		// NFD memisahkan huruf dasar dari tanda diakritiknya, misalnya "é" menjadi "e" + U+0301
+		for _, d := range norm.NFD.String(string(r)) {
+			if unicode.Is(unicode.Mn, d) {
+				continue
+			}
+
+			if replacement, ok := transliterations[d]; ok {
+				write(replacement, false)
+				continue
+			}
+
+			if d < unicode.MaxASCII && (unicode.IsLetter(d) || unicode.IsDigit(d)) {
+				write(string(d), false)
+				continue
+			}
+			// Kode Unicode diambil dari huruf utuh, misalnya suku kata Hangul, bukan dari hasil pecahan NFD-nya
+			if unicode.IsLetter(d) || unicode.IsDigit(d) {
+				write(fmt.Sprintf("u%06x", r), true)
+				break
+			}
+			if !lastDash {
+				b.WriteByte('-')
+				lastDash = true
+			}
+		}
+	}
+
+	return truncateSlug(strings.Trim(b.String(), "-"), MaxSlugLength)
+}
+
+// truncateSlug memotong slug ASCII pada tanda hubung terakhir sebelum batas panjang
+func truncateSlug(slug string, max int) string {
+	if len(slug) <= max {
+		return slug
+	}
+
+	slug = slug[:max]
+	if i := strings.LastIndexByte(slug, '-'); i > 0 {
+		slug = slug[:i]
+	} else if isCodePointSegment(slug) {
+		// Segmen kode Unicode tanpa tanda hubung dipotong per huruf, bukan di tengah kodenya
+		slug = slug[:len(slug)-len(slug)%codePointLength]
+	}
+	return strings.Trim(slug, "-")
+}
+
+// codePointLength adalah panjang satu huruf yang ditulis sebagai kode Unicode, "u" diikuti enam digit heksadesimal
+const codePointLength = 7
+
+// isCodePointSegment menandakan segment hanya berisi kode Unicode, huruf terakhirnya boleh terpotong
+func isCodePointSegment(segment string) bool {
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		if i%codePointLength == 0 {
+			if c != 'u' {
+				return false
+			}
+		} else if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return segment != ""
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"indonesian", "Cara Membuat Artikel yang Baik", "cara-membuat-artikel-yang-baik"},
+		{"punctuation and spaces", "  Hello,   World!  ", "hello-world"},
+		{"accented", "Kafé Ibu Déwi", "kafe-ibu-dewi"},
+		{"special latin letters", "Straße Æsir Łódź", "strasse-aesir-lodz"},
+		{"apostrophes", "Jum'at Don’t", "jumat-dont"},
+		{"cyrillic", "Привет мир", "privet-mir"},
+		{"ukrainian letters", "Їжак", "yizhak"},
+		{"greek", "Καλημέρα κόσμε", "kalimera-kosme"},
+		{"arabic", "مرحبا بالعالم", "mrhba-balalm"},
+		{"arabic digits", "٢٠٢٤", "2024"},
+		{"cjk", "入门", "u005165u0095e8"},
+		{"hangul syllables", "한국어", "u00d55cu00ad6du00c5b4"},
+		{"cjk separated from latin", "Go入门 guide", "go-u005165u0095e8-guide"},
+		{"latin after cjk", "入门go", "u005165u0095e8-go"},
+		{"code point above BMP", "𠀀", "u020000"},
+		{"only symbols", "!!! ???", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.text); got != tt.want {
+				t.Errorf("Slugify(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugifyMaxLength(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"cut at word boundary", strings.Repeat("abcdefghi ", 25), strings.TrimSuffix(strings.Repeat("abcdefghi-", 20), "-")},
+		{"long word without dash", strings.Repeat("a", 250), strings.Repeat("a", MaxSlugLength)},
+		{"cjk cut per letter", strings.Repeat("入", 40), strings.Repeat("u005165", MaxSlugLength/codePointLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Slugify(tt.text)
+			if got != tt.want {
+				t.Errorf("Slugify() = %q, want %q", got, tt.want)
+			}
+			if len(got) > MaxSlugLength {
+				t.Errorf("len = %d, want at most %d", len(got), MaxSlugLength)
+			}
+		})
+	}
+}
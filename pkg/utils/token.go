@@ -1,48 +1,200 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
-	"os"
+	"go-article/pkg/keyring"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// GenerateToken membuat JWT token untuk user
-func GenerateToken(userID uint) (string, error) {
-	claims := jwt.MapClaims{}
-	claims["user_id"] = userID
-	claims["exp"] = time.Now().Add(time.Hour * 24).Unix() // Token berlaku 24 jam
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	defaultPasswordResetTokenTTL = time.Hour
 
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		return "", errors.New("JWT_SECRET environment variable is not set")
-	}
+	defaultTokenIssuer   = "go-article"
+	defaultTokenAudience = "go-article-api"
+	defaultTokenLeeway   = 30 * time.Second
+)
 
-	return token.SignedString([]byte(secret))
+// AccessTokenTTL mengembalikan masa berlaku access token dari env ACCESS_TOKEN_TTL (contoh: 15m)
+func AccessTokenTTL() time.Duration {
+	return DurationFromEnv("ACCESS_TOKEN_TTL", defaultAccessTokenTTL)
 }
 
-// ValidateToken memvalidasi token JWT
-func ValidateToken(encodedToken string) (*jwt.Token, error) {
-	token, err := jwt.Parse(encodedToken, func(token *jwt.Token) (interface{}, error) {
-		_, ok := token.Method.(*jwt.SigningMethodHMAC)
-		if !ok {
-			return nil, errors.New("invalid token")
-		}
+// RefreshTokenTTL mengembalikan masa berlaku refresh token dari env REFRESH_TOKEN_TTL (contoh: 720h)
+func RefreshTokenTTL() time.Duration {
+	return DurationFromEnv("REFRESH_TOKEN_TTL", defaultRefreshTokenTTL)
+}
 
-		secret := os.Getenv("JWT_SECRET")
-		if secret == "" {
-			return nil, errors.New("JWT_SECRET environment variable is not set")
-		}
+// PasswordResetTokenTTL mengembalikan masa berlaku token reset password dari env PASSWORD_RESET_TOKEN_TTL
+func PasswordResetTokenTTL() time.Duration {
+	return DurationFromEnv("PASSWORD_RESET_TOKEN_TTL", defaultPasswordResetTokenTTL)
+}
 
-		return []byte(secret), nil
+var (
+	tokenKeyring     *keyring.Keyring
+	tokenKeyringErr  error
+	tokenKeyringOnce sync.Once
+)
+
+// UseKeyring mengatur keyring yang dipakai untuk menandatangani dan memverifikasi JWT.
+// Dipanggil sekali saat aplikasi start, tanpa ini keyring dimuat dari env saat pertama kali dibutuhkan.
+func UseKeyring(k *keyring.Keyring) {
+	tokenKeyringOnce.Do(func() {})
+	tokenKeyring = k
+	tokenKeyringErr = nil
+}
+
+// TokenKeyring mengembalikan keyring JWT aplikasi, dimuat sekali dari env (lihat keyring.NewFromEnv)
+func TokenKeyring() (*keyring.Keyring, error) {
+	tokenKeyringOnce.Do(func() {
+		tokenKeyring, tokenKeyringErr = keyring.NewFromEnv()
 	})
+	return tokenKeyring, tokenKeyringErr
+}
+
+// Claims adalah isi access token. Subject berisi ID user dalam bentuk string sesuai RFC 7519,
+// Roles hanya informasi untuk client dan service lain karena otorisasi tetap membaca role dari database.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles        []string `json:"roles,omitempty"`
+	TokenVersion uint64   `json:"ver"`
+}
+
+// UserID mengembalikan ID user dari claim sub
+func (c *Claims) UserID() (uint64, error) {
+	userID, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return 0, errors.New("invalid subject claim")
+	}
+	return userID, nil
+}
+
+// TokenSubject adalah data user yang dimasukkan ke access token
+type TokenSubject struct {
+	UserID       uint64
+	Roles        []string
+	TokenVersion uint64
+}
+
+// TokenIssuer mengembalikan claim iss access token dari env JWT_ISSUER
+func TokenIssuer() string {
+	return StringFromEnv("JWT_ISSUER", defaultTokenIssuer)
+}
+
+// TokenAudience mengembalikan claim aud access token dari env JWT_AUDIENCE
+func TokenAudience() string {
+	return StringFromEnv("JWT_AUDIENCE", defaultTokenAudience)
+}
+
+// TokenLeeway mengembalikan toleransi selisih jam server saat memeriksa exp, nbf dan iat dari env JWT_LEEWAY
+func TokenLeeway() time.Duration {
+	return DurationFromEnv("JWT_LEEWAY", defaultTokenLeeway)
+}
+
+// GenerateToken membuat access token JWT untuk user beserta claims-nya.
+// Setiap token mendapat jti acak sehingga bisa dicatat dan dicabut satu per satu.
+func GenerateToken(subject TokenSubject) (string, *Claims, error) {
+	tokenID, err := GenerateRandomID(16)
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    TokenIssuer(),
+			Subject:   strconv.FormatUint(subject.UserID, 10),
+			Audience:  jwt.ClaimStrings{TokenAudience()},
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL())),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        tokenID,
+		},
+		Roles:        subject.Roles,
+		TokenVersion: subject.TokenVersion,
+	}
+
+	token, err := signToken(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, claims, nil
+}
+
+// ValidateToken memvalidasi access token JWT: tanda tangan, iss, aud, exp, nbf dan iat (dengan leeway),
+// serta sub dan jti wajib ada. Token verifikasi email tidak punya aud sehingga ikut tertolak di sini.
+func ValidateToken(encodedToken string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := parseToken(encodedToken, claims,
+		jwt.WithIssuer(TokenIssuer()),
+		jwt.WithAudience(TokenAudience()),
+		jwt.WithLeeway(TokenLeeway()),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := claims.UserID(); err != nil {
+		return nil, err
+	}
+	if claims.ID == "" {
+		return nil, errors.New("missing jti claim")
+	}
 
+	return claims, nil
+}
+
+// signToken menandatangani claims dengan kunci aktif keyring
+func signToken(claims jwt.Claims) (string, error) {
+	keys, err := TokenKeyring()
+	if err != nil {
+		return "", err
+	}
+	return keys.Sign(claims)
+}
+
+// parseToken memverifikasi tanda tangan token dengan kunci yang sesuai kid-nya dan mengisi claims
+func parseToken(encodedToken string, claims jwt.Claims, options ...jwt.ParserOption) (*jwt.Token, error) {
+	keys, err := TokenKeyring()
 	if err != nil {
 		return nil, err
 	}
+	options = append(options, jwt.WithValidMethods(keyring.Algorithms()))
+	return jwt.ParseWithClaims(encodedToken, claims, keys.Keyfunc, options...)
+}
+
+// GenerateRandomToken membuat token opaque acak (base64 URL-safe) sepanjang size byte
+func GenerateRandomToken(size int) (string, error) {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// GenerateRandomID membuat ID acak dalam format hex sepanjang size byte
+func GenerateRandomID(size int) (string, error) {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
 
-	return token, nil
+// HashToken membuat hash SHA-256 dari token opaque agar token asli tidak pernah disimpan
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
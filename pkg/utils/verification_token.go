@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// PurposeEmailVerification menandai token yang hanya boleh dipakai untuk verifikasi email
+	PurposeEmailVerification = "email_verification"
+
+	defaultVerificationTokenTTL = 24 * time.Hour
+)
+
+// VerificationTokenTTL mengembalikan masa berlaku token verifikasi dari env VERIFICATION_TOKEN_TTL
+func VerificationTokenTTL() time.Duration {
+	return DurationFromEnv("VERIFICATION_TOKEN_TTL", defaultVerificationTokenTTL)
+}
+
+// GenerateVerificationToken membuat token bertanda tangan untuk verifikasi email.
+// Email ikut disimpan agar token lama tidak berlaku lagi jika email user berubah.
+func GenerateVerificationToken(userID uint64, email string) (string, error) {
+	claims := jwt.MapClaims{}
+	claims["purpose"] = PurposeEmailVerification
+	claims["user_id"] = userID
+	claims["email"] = email
+	claims["exp"] = time.Now().Add(VerificationTokenTTL()).Unix()
+
+	return signToken(claims)
+}
+
+// ParseVerificationToken memvalidasi token verifikasi dan mengembalikan user ID serta email-nya
+func ParseVerificationToken(encodedToken string) (uint64, string, error) {
+	claims := jwt.MapClaims{}
+	token, err := parseToken(encodedToken, claims)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if !token.Valid || claims["purpose"] != PurposeEmailVerification {
+		return 0, "", errors.New("invalid token")
+	}
+
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, "", errors.New("invalid token")
+	}
+	email, _ := claims["email"].(string)
+
+	return uint64(userID), email, nil
+}
@@ -0,0 +1,206 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-article/pkg/apperror"
+	"io"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	idTranslations "github.com/go-playground/validator/v10/translations/id"
+)
+
+// FieldError adalah detail kegagalan validasi untuk satu field
+type FieldError struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Params  map[string]string `json:"params,omitempty"`
+}
+
+// Errors adalah kumpulan FieldError yang dikunci dengan nama field JSON
+type Errors map[string]FieldError
+
+var (
+	setupOnce  sync.Once
+	translator *ut.UniversalTranslator
+)
+
+// Setup mendaftarkan nama field JSON dan terjemahan en/id ke validator milik gin.
+// Dipanggil sekali saat router dibuat, sebelum request pertama divalidasi.
+func Setup() {
+	setupOnce.Do(func() {
+		enLocale := en.New()
+		translator = ut.New(enLocale, enLocale, id.New())
+
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		v.RegisterTagNameFunc(fieldName)
+		registerCustomValidations(v)
+
+		enTrans, _ := translator.GetTranslator("en")
+		if err := enTranslations.RegisterDefaultTranslations(v, enTrans); err != nil {
+			log.Printf("Failed to register en validation translations: %v", err)
+		}
+		enTrans.Add("json_type", "{0} must be a valid {1}", false)
+
+		idTrans, _ := translator.GetTranslator("id")
+		if err := idTranslations.RegisterDefaultTranslations(v, idTrans); err != nil {
+			log.Printf("Failed to register id validation translations: %v", err)
+		}
+		idTrans.Add("json_type", "{0} harus berupa {1} yang valid", false)
+
+		registerTranslation(v, enTrans, "url_or_empty", "{0} must be a valid URL or empty")
+		registerTranslation(v, idTrans, "url_or_empty", "{0} harus berupa URL yang valid atau kosong")
+		registerTranslation(v, enTrans, "notblank", "{0} must not be blank")
+		registerTranslation(v, idTrans, "notblank", "{0} tidak boleh kosong")
+	})
+}
+
+// registerCustomValidations mendaftarkan tag validasi yang tidak tersedia bawaan validator:
+//   - url_or_empty: string kosong atau URL yang valid, untuk field opsional yang bisa dikosongkan (misalnya avatar)
+//   - notblank: tidak kosong dan tidak hanya berisi spasi, untuk teks yang disimpan setelah di-trim
+func registerCustomValidations(v *validator.Validate) {
+	v.RegisterValidation("notblank", validators.NotBlank)
+	v.RegisterValidation("url_or_empty", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		return value == "" || v.Var(value, "url") == nil
+	})
+}
+
+// registerTranslation mendaftarkan pesan untuk tag custom, {0} diganti nama field
+func registerTranslation(v *validator.Validate, trans ut.Translator, tag string, text string) {
+	err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+		return ut.Add(tag, text, true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		message, err := ut.T(tag, fe.Field())
+		if err != nil {
+			return fe.Error()
+		}
+		return message
+	})
+	if err != nil {
+		log.Printf("Failed to register %s validation translation: %v", tag, err)
+	}
+}
+
+// Translate mengubah error dari ShouldBind menjadi apperror dengan pesan sesuai
+// bahasa pada header Accept-Language. Error JSON yang rusak tidak lagi membuat panic.
+func Translate(err error, acceptLanguage string) *apperror.Error {
+	Setup()
+	trans := findTranslator(acceptLanguage)
+
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return apperror.Validation(fromValidationErrors(validationErrors, trans)).Wrap(err)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		field := typeErr.Field
+		if field == "" {
+			field = "body"
+		}
+		expected := typeErr.Type.Kind().String()
+		message, tErr := trans.T("json_type", field, expected)
+		if tErr != nil {
+			message = fmt.Sprintf("%s must be a valid %s", field, expected)
+		}
+
+		details := Errors{
+			field: {
+				Code:    "type",
+				Message: message,
+				Params:  map[string]string{"type": expected},
+			},
+		}
+		return apperror.Validation(details).Wrap(err)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return apperror.BadRequest("INVALID_JSON", "Request body is not valid JSON").Wrap(err)
+	}
+
+	if errors.Is(err, io.EOF) {
+		return apperror.BadRequest("EMPTY_BODY", "Request body is empty").Wrap(err)
+	}
+
+	return apperror.BadRequest("INVALID_REQUEST", "Invalid request").Wrap(err)
+}
+
+// fromValidationErrors menyusun Errors, hanya error pertama per field yang dipakai
+func fromValidationErrors(validationErrors validator.ValidationErrors, trans ut.Translator) Errors {
+	details := make(Errors, len(validationErrors))
+	for _, e := range validationErrors {
+		field := fieldPath(e)
+		if _, exists := details[field]; exists {
+			continue
+		}
+
+		message := e.Translate(trans)
+		if message == e.Error() {
+			// Tag tanpa terjemahan (misalnya tag custom) tetap diberi pesan yang bisa dibaca
+			message = fmt.Sprintf("%s failed on the '%s' rule", e.Field(), e.Tag())
+		}
+
+		fieldError := FieldError{Code: e.Tag(), Message: message}
+		if e.Param() != "" {
+			fieldError.Params = map[string]string{e.Tag(): e.Param()}
+		}
+		details[field] = fieldError
+	}
+	return details
+}
+
+// fieldPath membuang nama struct teratas dari namespace, misalnya "LoginRequest.email" menjadi "email"
+func fieldPath(e validator.FieldError) string {
+	namespace := e.Namespace()
+	if index := strings.Index(namespace, "."); index >= 0 {
+		return namespace[index+1:]
+	}
+	return e.Field()
+}
+
+// fieldName memakai nama dari tag json, lalu tag form untuk query, agar sama dengan yang dikirim client
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name := strings.Split(field.Tag.Get(tag), ",")[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// findTranslator memilih translator dari header Accept-Language, misalnya "id-ID,id;q=0.9,en;q=0.8".
+// Urutan header diasumsikan sudah sesuai prioritas, fallback ke bahasa Inggris.
+func findTranslator(acceptLanguage string) ut.Translator {
+	var locales []string
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.Split(part, ";")[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		primary := strings.ToLower(strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")[0])
+		locales = append(locales, primary)
+	}
+
+	trans, _ := translator.FindTranslator(locales...)
+	return trans
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Client mengirim event ke satu URL webhook dalam bentuk JSON.
+// Jika Secret diisi, body ditandatangani HMAC-SHA256 pada header X-Webhook-Signature
+// sehingga penerima bisa memastikan event benar berasal dari aplikasi ini.
+type Client struct {
+	URL        string
+	Secret     string
+	HTTPClient *http.Client
+}
+
+// payload adalah isi body yang dikirim ke penerima webhook
+type payload struct {
+	Event  string      `json:"event"`
+	Data   interface{} `json:"data"`
+	SentAt time.Time   `json:"sent_at"`
+}
+
+// NewClient membuat Client dengan timeout HTTP bawaan
+func NewClient(url string, secret string) *Client {
+	return &Client{
+		URL:        url,
+		Secret:     secret,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send mengirim event ke URL webhook, response non-2xx dianggap gagal
+func (c *Client) Send(ctx context.Context, event string, data interface{}) error {
+	body, err := json.Marshal(payload{Event: event, Data: data, SentAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", event)
+	if c.Secret != "" {
+		req.Header.Set("X-Webhook-Signature", "sha256="+Sign(c.Secret, body))
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %d", c.URL, res.StatusCode)
+	}
+	return nil
+}
+
+// Sign menghitung tanda tangan HMAC-SHA256 (hex) dari body dengan secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}